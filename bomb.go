@@ -0,0 +1,271 @@
+package csgolog
+
+import (
+	"math"
+	"path"
+	"time"
+)
+
+// DefaultC4Timer is the time between plant and explosion of the
+// bomb if the server does not log mp_c4timer
+const DefaultC4Timer = 40 * time.Second
+
+// Bombsites holds the approximate centers of the bombsites per map, they
+// are used to infer the site of a plant from the position of the planter.
+// The maps package resolves the callouts of the bombsites by them too, add
+// the sites of further maps to infer their sites and callouts
+var Bombsites = map[string]map[string]Position{
+	"de_dust2": {
+		"A": {X: 1150, Y: 2500, Z: 100},
+		"B": {X: -1500, Y: 2600, Z: 30},
+	},
+	"de_mirage": {
+		"A": {X: -350, Y: -2100, Z: -170},
+		"B": {X: -2000, Y: 350, Z: -160},
+	},
+	"de_inferno": {
+		"A": {X: 2100, Y: 450, Z: 160},
+		"B": {X: 400, Y: 2900, Z: 160},
+	},
+	"de_nuke": {
+		"A": {X: 650, Y: -800, Z: -410},
+		"B": {X: 550, Y: -900, Z: -770},
+	},
+	"de_overpass": {
+		"A": {X: -2100, Y: 700, Z: 0},
+		"B": {X: -1100, Y: 100, Z: 0},
+	},
+	"de_vertigo": {
+		"A": {X: -250, Y: -300, Z: 11700},
+		"B": {X: -2150, Y: 750, Z: 11700},
+	},
+	"de_ancient": {
+		"A": {X: -1600, Y: 900, Z: 0},
+		"B": {X: 1000, Y: 400, Z: 0},
+	},
+	"de_anubis": {
+		"A": {X: 1300, Y: 2000, Z: 0},
+		"B": {X: -1600, Y: 1700, Z: 0},
+	},
+	"de_train": {
+		"A": {X: -200, Y: 150, Z: 0},
+		"B": {X: 100, Y: -1450, Z: 0},
+	},
+	"de_cache": {
+		"A": {X: 800, Y: 2100, Z: 0},
+		"B": {X: -300, Y: -1000, Z: 0},
+	},
+}
+
+type (
+
+	// BombEvent holds a single event of the bomb
+	BombEvent struct {
+		Time   time.Time `json:"time"`
+		Type   string    `json:"type"`
+		Player Player    `json:"player"`
+	}
+
+	// BombTimeline holds the events of the bomb in a round. TimeRemaining
+	// is the time left on the bomb when it got defused, or when the last
+	// defuse began if it was not defused
+	BombTimeline struct {
+		Events        []BombEvent   `json:"events"`
+		Carrier       Player        `json:"carrier"`
+		Planted       bool          `json:"planted"`
+		PlantTime     time.Time     `json:"plant_time"`
+		Planter       Player        `json:"planter"`
+		Site          string        `json:"site"`
+		DefuseStart   time.Time     `json:"defuse_start"`
+		Defuser       Player        `json:"defuser"`
+		Kit           bool          `json:"kit"`
+		Defused       bool          `json:"defused"`
+		DefuseTime    time.Time     `json:"defuse_time"`
+		TimeRemaining time.Duration `json:"time_remaining"`
+		Exploded      bool          `json:"exploded"`
+		Outcome       string        `json:"outcome"`
+	}
+
+	// BombTracker assembles the bomb timeline of each round
+	BombTracker struct {
+		Timelines []*BombTimeline `json:"timelines"`
+		Map       string          `json:"map"`
+		C4Timer   time.Duration   `json:"c4_timer"`
+
+		current   *BombTimeline
+		positions map[int]Position
+	}
+)
+
+// NewBombTracker returns a BombTracker without any rounds
+func NewBombTracker() *BombTracker {
+	return &BombTracker{
+		Timelines: []*BombTimeline{},
+		C4Timer:   DefaultC4Timer,
+		positions: map[int]Position{},
+	}
+}
+
+// BombTimelines returns the bomb timeline of each round of the messages
+func BombTimelines(msgs []Message) []*BombTimeline {
+
+	b := NewBombTracker()
+
+	for _, m := range msgs {
+		b.Update(m)
+	}
+
+	return b.Timelines
+}
+
+// Update adds the message to the timeline of the current round. If the
+// server does not log the bombsite, the site is inferred from the last
+// position of the planter in the round by the Bombsites of the map
+func (b *BombTracker) Update(msg Message) {
+
+	switch msg := msg.(type) {
+
+	case ServerCvar:
+		b.setCvar(msg.Name, msg.Value)
+
+	case CvarDump:
+		b.setCvar(msg.Name, msg.Value)
+
+	case LoadingMap:
+		b.Map = msg.Map
+
+	case WorldMatchStart:
+		b.Map = msg.Map
+		b.current = nil
+
+	case FreezTimeStart:
+		b.start()
+
+	case WorldRoundStart:
+		if b.current == nil {
+			b.start()
+		}
+
+	case WorldRoundEnd:
+		b.current = nil
+
+	case PlayerBombGot:
+		b.event(msg.Meta, msg.Player).Carrier = msg.Player
+
+	case PlayerBombDropped:
+		b.event(msg.Meta, msg.Player).Carrier = Player{}
+
+	case PlayerBombPlanted:
+		t := b.event(msg.Meta, msg.Player)
+		t.Planted = true
+		t.PlantTime = msg.Time
+		t.Planter = msg.Player
+		t.Carrier = Player{}
+		t.Site = msg.Site
+
+		if pos, ok := b.positions[msg.Player.ID]; ok && t.Site == "" {
+			t.Site = NearestBombsite(b.Map, pos)
+		}
+
+	case PlayerBombBeginDefuse:
+		t := b.event(msg.Meta, msg.Player)
+		t.DefuseStart = msg.Time
+		t.Defuser = msg.Player
+		t.Kit = msg.Kit
+		t.TimeRemaining = b.remaining(t, msg.Time)
+
+	case PlayerBombDefused:
+		t := b.event(msg.Meta, msg.Player)
+		t.Defused = true
+		t.DefuseTime = msg.Time
+		t.Defuser = msg.Player
+		t.TimeRemaining = b.remaining(t, msg.Time)
+
+	case TeamNotice:
+		if b.current != nil {
+			b.current.Outcome = WinReason(msg.Notice)
+			b.current.Exploded = b.current.Outcome == WinReasonBombExploded
+		}
+
+	case PlayerAttack:
+		b.position(msg.Attacker, msg.AttackerPosition)
+		b.position(msg.Victim, msg.VictimPosition)
+
+	case PlayerKill:
+		b.position(msg.Attacker, msg.AttackerPosition)
+
+	case PlayerThrew:
+		b.position(msg.Player, msg.Position)
+	}
+}
+
+// start begins the timeline of a new round
+func (b *BombTracker) start() {
+
+	b.current = &BombTimeline{Events: []BombEvent{}}
+	b.Timelines = append(b.Timelines, b.current)
+	b.positions = map[int]Position{}
+}
+
+// event adds an event to the current timeline, a round is
+// started if the events are logged outside of a round
+func (b *BombTracker) event(meta Meta, p Player) *BombTimeline {
+
+	if b.current == nil {
+		b.start()
+	}
+
+	b.current.Events = append(b.current.Events, BombEvent{
+		Time:   meta.Time,
+		Type:   meta.Type,
+		Player: p,
+	})
+
+	return b.current
+}
+
+// position remembers the last known position of a player in the round
+func (b *BombTracker) position(p Player, pos Position) {
+	b.positions[p.ID] = pos
+}
+
+// remaining returns the time left on a planted bomb at ti
+func (b *BombTracker) remaining(t *BombTimeline, ti time.Time) time.Duration {
+
+	if !t.Planted {
+		return 0
+	}
+
+	return b.C4Timer - ti.Sub(t.PlantTime)
+}
+
+// setCvar takes over the timer of the bomb
+func (b *BombTracker) setCvar(name, value string) {
+
+	if name == "mp_c4timer" {
+		b.C4Timer = time.Duration(toInt(value)) * time.Second
+	}
+}
+
+// NearestBombsite returns the bombsite of the map nearest to the position,
+// empty if the bombsites of the map are not known
+func NearestBombsite(mapName string, pos Position) string {
+
+	site := ""
+	nearest := math.MaxFloat64
+
+	// workshop maps are logged with their path
+	for name, center := range Bombsites[path.Base(mapName)] {
+
+		dx := float64(pos.X - center.X)
+		dy := float64(pos.Y - center.Y)
+		dz := float64(pos.Z - center.Z)
+
+		if d := dx*dx + dy*dy + dz*dz; d < nearest {
+			nearest = d
+			site = name
+		}
+	}
+
+	return site
+}
@@ -0,0 +1,104 @@
+package csgolog
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestBombTracker(t *testing.T) {
+
+	t.Run("defused round", func(t *testing.T) {
+
+		// given
+		msgs := []Message{}
+		for i, l := range []string{
+			`World triggered "Match_Start" on "de_dust2"`,
+			`Starting Freeze period`,
+			`World triggered "Round_Start"`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" triggered "Got_The_Bomb"`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" threw flashbang [-1480 2580 30] flashbang entindex 163)`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" triggered "Planted_The_Bomb"`,
+			`"Bravo<3><STEAM_1:0:1002><CT>" triggered "Begin_Bomb_Defuse_Without_Kit"`,
+			`"Charlie<4><STEAM_1:0:1003><CT>" triggered "Begin_Bomb_Defuse_With_Kit"`,
+			`"Charlie<4><STEAM_1:0:1003><CT>" triggered "Defused_The_Bomb"`,
+			`Team "CT" triggered "SFUI_Notice_Bomb_Defused" (CT "1") (T "0")`,
+			`World triggered "Round_End"`,
+		} {
+			m, _ := Parse(lineAt(i, l))
+			msgs = append(msgs, m)
+		}
+
+		// when
+		b := BombTimelines(msgs)
+
+		// then
+		assert(t, 1, len(b))
+		assert(t, 5, len(b[0].Events))
+		assert(t, "PlayerBombGot", b[0].Events[0].Type)
+		assert(t, true, b[0].Planted)
+		assert(t, "Alpha", b[0].Planter.Name)
+		assert(t, "B", b[0].Site)
+		assert(t, msgs[5].GetTime(), b[0].PlantTime)
+		assert(t, msgs[7].GetTime(), b[0].DefuseStart)
+		assert(t, "Charlie", b[0].Defuser.Name)
+		assert(t, true, b[0].Kit)
+		assert(t, true, b[0].Defused)
+		assert(t, msgs[8].GetTime(), b[0].DefuseTime)
+		assert(t, 37*time.Second, b[0].TimeRemaining)
+		assert(t, false, b[0].Exploded)
+		assert(t, WinReasonBombDefused, b[0].Outcome)
+	})
+
+	t.Run("exploded round with logged bombsite", func(t *testing.T) {
+
+		// when
+		b := BombTimelines(parseLines(
+			`"mp_c4timer" = "35"`,
+			`World triggered "Match_Start" on "de_dust2"`,
+			`Starting Freeze period`,
+			`World triggered "Round_End"`,
+			`Starting Freeze period`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" [1150 2500 100] killed "Bravo<3><STEAM_1:0:1002><CT>" [1000 2400 100] with "ak47"`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" triggered "Planted_The_Bomb" at bombsite B`,
+			`Team "TERRORIST" triggered "SFUI_Notice_Target_Bombed" (CT "0") (T "1")`,
+			`World triggered "Round_End"`,
+		))
+
+		// then
+		assert(t, 2, len(b))
+		assert(t, 0, len(b[0].Events))
+		assert(t, false, b[0].Planted)
+		assert(t, "B", b[1].Site)
+		assert(t, true, b[1].Exploded)
+		assert(t, false, b[1].Defused)
+		assert(t, WinReasonBombExploded, b[1].Outcome)
+	})
+
+	t.Run("c4 timer", func(t *testing.T) {
+
+		// given
+		b := NewBombTracker()
+
+		// when
+		b.Update(ServerCvar{Name: "mp_c4timer", Value: "35"})
+
+		// then
+		assert(t, 35*time.Second, b.C4Timer)
+	})
+}
+
+func TestNearestBombsite(t *testing.T) {
+
+	// then
+	assert(t, "A", NearestBombsite("de_dust2", Position{1100, 2400, 100}))
+	assert(t, "B", NearestBombsite("workshop/123/de_dust2", Position{-1400, 2500, 0}))
+	assert(t, "A", NearestBombsite("de_nuke", Position{600, -850, -400}))
+	assert(t, "B", NearestBombsite("de_nuke", Position{600, -850, -760}))
+	assert(t, "", NearestBombsite("de_foo", Position{0, 0, 0}))
+}
+
+// lineAt returns a log line logged sec seconds into the minute
+func lineAt(sec int, l string) string {
+	return fmt.Sprintf("L 11/05/2018 - 15:44:%02d: %s\n", sec, l)
+}
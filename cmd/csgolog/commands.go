@@ -0,0 +1,235 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/janstuemmel/csgo-log"
+)
+
+// roundSummary is a round without its messages
+type roundSummary struct {
+	Number   int       `json:"number"`
+	Kind     string    `json:"kind"`
+	Start    time.Time `json:"start"`
+	Duration float64   `json:"duration"`
+	Winner   string    `json:"winner"`
+	Reason   string    `json:"reason"`
+	ScoreCT  int       `json:"score_ct"`
+	ScoreT   int       `json:"score_t"`
+	Kills    int       `json:"kills"`
+}
+
+// runParse writes all messages of the inputs
+func runParse(args []string, stdout io.Writer) error {
+
+	opts := options{}
+	fs := newFlagSet("parse", "json", &opts)
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	return writeMessages(fs.Args(), opts, stdout, func(m csgolog.Message) bool {
+		return true
+	})
+}
+
+// runUnknown writes the messages no pattern matched
+func runUnknown(args []string, stdout io.Writer) error {
+
+	opts := options{}
+	fs := newFlagSet("unknown", "text", &opts)
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	return writeMessages(fs.Args(), opts, stdout, func(m csgolog.Message) bool {
+		_, ok := m.(csgolog.Unknown)
+		return ok
+	})
+}
+
+// runStats writes the scoreboard of the live rounds
+func runStats(args []string, stdout io.Writer) error {
+
+	opts := options{}
+	fs := newFlagSet("stats", "text", &opts)
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	msgs, err := loadMessages(fs.Args(), opts)
+
+	if err != nil {
+		return err
+	}
+
+	stats := csgolog.Scoreboard(msgs)
+	header := []string{"name", "steam_id", "rounds", "kills", "deaths", "assists", "flash_assists", "hs", "adr"}
+	rows := [][]string{}
+
+	for _, s := range stats {
+		rows = append(rows, []string{
+			s.Player.Name,
+			s.Player.SteamID,
+			strconv.Itoa(s.Rounds),
+			strconv.Itoa(s.Kills),
+			strconv.Itoa(s.Deaths),
+			strconv.Itoa(s.Assists),
+			strconv.Itoa(s.FlashAssists),
+			strconv.Itoa(s.Headshots),
+			strconv.FormatFloat(float64(s.ADR), 'f', 1, 32),
+		})
+	}
+
+	return writeTable(stdout, opts.format, header, rows, stats)
+}
+
+// runRounds writes a summary of each round
+func runRounds(args []string, stdout io.Writer) error {
+
+	opts := options{}
+	fs := newFlagSet("rounds", "text", &opts)
+	all := fs.Bool("all", false, "include warmup and knife rounds")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	msgs, err := loadMessages(fs.Args(), opts)
+
+	if err != nil {
+		return err
+	}
+
+	rounds := csgolog.Rounds(msgs)
+
+	if !*all {
+		rounds = csgolog.LiveRounds(rounds)
+	}
+
+	header := []string{"number", "kind", "start", "duration", "winner", "reason", "score", "kills"}
+	rows := [][]string{}
+	summaries := []roundSummary{}
+
+	for _, r := range rounds {
+
+		s := roundSummary{
+			Number:   r.Number,
+			Kind:     r.Kind,
+			Start:    r.Start,
+			Duration: r.End.Sub(r.Start).Seconds(),
+			Winner:   r.Winner,
+			Reason:   r.Reason,
+			ScoreCT:  r.ScoreCT,
+			ScoreT:   r.ScoreT,
+		}
+
+		for _, m := range r.Messages {
+			if _, ok := m.(csgolog.PlayerKill); ok {
+				s.Kills++
+			}
+		}
+
+		summaries = append(summaries, s)
+		rows = append(rows, []string{
+			strconv.Itoa(s.Number),
+			s.Kind,
+			s.Start.Format(timeFormat),
+			strconv.FormatFloat(s.Duration, 'f', 0, 64) + "s",
+			s.Winner,
+			s.Reason,
+			fmt.Sprintf("%d:%d", s.ScoreCT, s.ScoreT),
+			strconv.Itoa(s.Kills),
+		})
+	}
+
+	return writeTable(stdout, opts.format, header, rows, summaries)
+}
+
+// runTail follows a logfile and writes its new messages
+func runTail(args []string, stdout io.Writer) error {
+
+	opts := options{}
+	fs := newFlagSet("tail", "json", &opts)
+	fromStart := fs.Bool("from-start", false, "read the file from the start instead of its end")
+	interval := fs.Duration("interval", 500*time.Millisecond, "interval to check the file for new lines")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	names, err := inputs(fs.Args(), opts.globs)
+
+	if err != nil {
+		return err
+	}
+
+	if len(names) != 1 || names[0] == stdin {
+		return fmt.Errorf("tail follows exactly one file")
+	}
+
+	w, err := newMessageWriter(opts.format, stdout)
+
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(names[0])
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	fl, err := newFollower(f, *interval, *fromStart)
+
+	if err != nil {
+		return err
+	}
+
+	r := csgolog.NewReader(fl)
+
+	return readAll(r, func(m csgolog.Message) error {
+		if err := w.Write(m); err != nil {
+			return err
+		}
+		return w.Flush()
+	})
+}
+
+// writeMessages writes the messages of the inputs accepted by filter
+func writeMessages(args []string, opts options, stdout io.Writer, filter func(m csgolog.Message) bool) error {
+
+	names, err := inputs(args, opts.globs)
+
+	if err != nil {
+		return err
+	}
+
+	w, err := newMessageWriter(opts.format, stdout)
+
+	if err != nil {
+		return err
+	}
+
+	err = readMessages(names, func(m csgolog.Message) error {
+		if !filter(m) {
+			return nil
+		}
+		return w.Write(m)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
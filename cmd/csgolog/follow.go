@@ -0,0 +1,79 @@
+package main
+
+import (
+	"io"
+	"os"
+	"time"
+)
+
+// follower reads a file and waits for new data at its end instead of
+// returning io.EOF. A file truncated by the server is read from the start
+type follower struct {
+	file     *os.File
+	interval time.Duration
+	offset   int64
+}
+
+// newFollower returns a follower of the file starting at its end,
+// or at its start if fromStart is set
+func newFollower(file *os.File, interval time.Duration, fromStart bool) (*follower, error) {
+
+	f := &follower{file: file, interval: interval}
+
+	if fromStart {
+		return f, nil
+	}
+
+	offset, err := file.Seek(0, io.SeekEnd)
+
+	if err != nil {
+		return nil, err
+	}
+
+	f.offset = offset
+
+	return f, nil
+}
+
+func (f *follower) Read(p []byte) (int, error) {
+
+	for {
+
+		n, err := f.file.Read(p)
+		f.offset += int64(n)
+
+		if n > 0 {
+			return n, nil
+		}
+
+		if err != nil && err != io.EOF {
+			return 0, err
+		}
+
+		time.Sleep(f.interval)
+
+		if err := f.truncated(); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// truncated seeks to the start of the file if it got shorter
+// than the offset read so far
+func (f *follower) truncated() error {
+
+	info, err := f.file.Stat()
+
+	if err != nil {
+		return err
+	}
+
+	if info.Size() >= f.offset {
+		return nil
+	}
+
+	f.offset = 0
+	_, err = f.file.Seek(0, io.SeekStart)
+
+	return err
+}
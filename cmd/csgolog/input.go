@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/janstuemmel/csgo-log"
+)
+
+// stdin is the input name reading from STDIN
+const stdin = "-"
+
+// options holds the flags shared by all commands
+type options struct {
+	format string
+	globs  globs
+}
+
+// globs collects the patterns of repeated -glob flags
+type globs []string
+
+func (g *globs) String() string {
+	return strings.Join(*g, ",")
+}
+
+func (g *globs) Set(v string) error {
+	*g = append(*g, v)
+	return nil
+}
+
+// newFlagSet returns the flags of a command with the shared flags
+// registered, format is the default output format of the command
+func newFlagSet(name, format string, opts *options) *flag.FlagSet {
+
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.StringVar(&opts.format, "format", format, "output format: json, csv or text")
+	fs.Var(&opts.globs, "glob", "read the files matching the pattern, can be repeated")
+
+	return fs
+}
+
+// inputs returns the files to read in order, arguments with glob
+// characters and the glob flags are expanded, STDIN if there are none
+func inputs(args []string, patterns []string) ([]string, error) {
+
+	files := []string{}
+
+	for _, a := range args {
+
+		if !strings.ContainsAny(a, "*?[") {
+			files = append(files, a)
+			continue
+		}
+
+		patterns = append(patterns, a)
+	}
+
+	for _, p := range patterns {
+
+		matches, err := filepath.Glob(p)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no files match %q", p)
+		}
+
+		files = append(files, matches...)
+	}
+
+	if len(files) == 0 {
+		files = append(files, stdin)
+	}
+
+	return files, nil
+}
+
+// open opens an input, gzipped files are detected by
+// their magic number and decompressed
+func open(name string) (io.ReadCloser, error) {
+
+	var f io.ReadCloser = os.Stdin
+
+	if name != stdin {
+
+		file, err := os.Open(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		f = file
+	}
+
+	r := bufio.NewReader(f)
+	magic, _ := r.Peek(2)
+
+	if len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+
+		gz, err := gzip.NewReader(r)
+
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+
+		return readCloser{gz, f}, nil
+	}
+
+	return readCloser{r, f}, nil
+}
+
+// readCloser reads from a wrapping reader and closes the file
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// readMessages calls fn for each message of the inputs in order,
+// lines not being log lines are skipped
+func readMessages(names []string, fn func(m csgolog.Message) error) error {
+
+	for _, name := range names {
+
+		f, err := open(name)
+
+		if err != nil {
+			return err
+		}
+
+		err = readAll(csgolog.NewReader(f), fn)
+		f.Close()
+
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+	}
+
+	return nil
+}
+
+// readAll calls fn for each message of r until its end
+func readAll(r *csgolog.Reader, fn func(m csgolog.Message) error) error {
+
+	for {
+
+		m, err := r.Read()
+
+		// a round stats block may be cut off by the end of the file
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+
+		if _, ok := err.(*time.ParseError); ok || err == csgolog.ErrorNoMatch {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := fn(m); err != nil {
+			return err
+		}
+	}
+}
+
+// loadMessages returns all messages of the inputs
+func loadMessages(args []string, opts options) ([]csgolog.Message, error) {
+
+	names, err := inputs(args, opts.globs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := []csgolog.Message{}
+
+	err = readMessages(names, func(m csgolog.Message) error {
+		msgs = append(msgs, m)
+		return nil
+	})
+
+	return msgs, err
+}
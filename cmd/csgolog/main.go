@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// Usage:
+//
+// Parse logfiles to JSON, one message per line:
+// csgolog parse example.log
+//
+// Parse all logfiles of a directory, gzipped or not, to CSV:
+// csgolog parse -format csv -glob 'logs/*.log*'
+//
+// Scoreboard over the live rounds:
+// csgolog stats example.log
+//
+// Round summary:
+// csgolog rounds example.log
+//
+// List log lines no pattern matched:
+// csgolog unknown example.log
+//
+// Follow a logfile of a running server:
+// csgolog tail logs/L000_000_000_000_27015_202610182000_000.log
+//
+// Read from STDIN:
+// cat example.log | csgolog parse
+
+// command is a subcommand of the tool
+type command struct {
+	usage string
+	run   func(args []string, stdout io.Writer) error
+}
+
+var commands = map[string]command{
+	"parse":   {"parse messages to JSON, CSV or text", runParse},
+	"stats":   {"print the scoreboard of the live rounds", runStats},
+	"rounds":  {"print a summary of each round", runRounds},
+	"unknown": {"list log lines no pattern matched", runUnknown},
+	"tail":    {"follow a logfile and parse new messages", runTail},
+}
+
+func main() {
+
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+
+	if name == "help" || name == "-h" || name == "-help" {
+		usage(os.Stdout)
+		return
+	}
+
+	cmd, ok := commands[name]
+
+	if !ok {
+		fmt.Fprintf(os.Stderr, "csgolog: unknown command %q\n\n", name)
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+
+	if err := cmd.run(os.Args[2:], os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "csgolog %s: %v\n", name, err)
+		os.Exit(1)
+	}
+}
+
+// usage prints the commands of the tool
+func usage(w io.Writer) {
+
+	fmt.Fprintln(w, "Usage: csgolog <command> [flags] [files]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+
+	names := []string{}
+
+	for name := range commands {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-8s %s\n", name, commands[name].usage)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run csgolog <command> -h for the flags of a command. Without")
+	fmt.Fprintln(w, "files or globs the messages are read from STDIN, gzipped")
+	fmt.Fprintln(w, "logfiles are detected and decompressed.")
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const exampleLog = "../../example/example.log"
+
+func TestInputs(t *testing.T) {
+
+	t.Run("stdin", func(t *testing.T) {
+
+		// when
+		files, err := inputs(nil, nil)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "-", strings.Join(files, ","))
+	})
+
+	t.Run("files and globs", func(t *testing.T) {
+
+		// when
+		files, err := inputs([]string{"a.log", "../../testdata/w*.log"}, []string{"../../testdata/d*.log"})
+
+		// then
+		assert(t, nil, err)
+		assert(t, "a.log,../../testdata/deathmatch.log,../../testdata/wingman.log", strings.Join(files, ","))
+	})
+
+	t.Run("no match", func(t *testing.T) {
+
+		// when
+		_, err := inputs(nil, []string{"../../testdata/*.nope"})
+
+		// then
+		assert(t, true, err != nil)
+	})
+}
+
+func TestOpen(t *testing.T) {
+
+	t.Run("gzip", func(t *testing.T) {
+
+		// given
+		dir, _ := ioutil.TempDir("", "csgolog")
+		defer os.RemoveAll(dir)
+
+		name := filepath.Join(dir, "example.log")
+		buf := &bytes.Buffer{}
+		gz := gzip.NewWriter(buf)
+		gz.Write([]byte("L 11/12/2018 - 19:57:28: World triggered \"Round_Start\"\n"))
+		gz.Close()
+		ioutil.WriteFile(name, buf.Bytes(), 0644)
+
+		// when
+		f, err := open(name)
+		b, _ := ioutil.ReadAll(f)
+		f.Close()
+
+		// then
+		assert(t, nil, err)
+		assert(t, true, strings.HasSuffix(string(b), "\"Round_Start\"\n"))
+	})
+}
+
+func TestFollower(t *testing.T) {
+
+	t.Run("truncated after seek to end", func(t *testing.T) {
+
+		// given
+		dir, _ := ioutil.TempDir("", "csgolog")
+		defer os.RemoveAll(dir)
+
+		name := filepath.Join(dir, "server.log")
+		ioutil.WriteFile(name, []byte("L 11/12/2018 - 19:57:28: World triggered \"Round_Start\"\n"), 0644)
+
+		f, _ := os.Open(name)
+		defer f.Close()
+		r, err := newFollower(f, time.Millisecond, false)
+		assert(t, nil, err)
+
+		ioutil.WriteFile(name, []byte("L 11/12/2018 - 19:58:00: Log file closed\n"), 0644)
+
+		// when
+		read := make(chan string)
+		go func() {
+			b := make([]byte, 64)
+			n, _ := r.Read(b)
+			read <- string(b[:n])
+		}()
+
+		// then
+		select {
+		case s := <-read:
+			assert(t, "L 11/12/2018 - 19:58:00: Log file closed\n", s)
+		case <-time.After(time.Second):
+			t.Error("Assertion failed for", t.Name(), "\n\ttruncated file not read from the start")
+		}
+	})
+}
+
+func TestCommands(t *testing.T) {
+
+	t.Run("parse", func(t *testing.T) {
+
+		// given
+		out := &bytes.Buffer{}
+
+		// when
+		err := runParse([]string{"-format", "csv", exampleLog}, out)
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+
+		// then
+		assert(t, nil, err)
+		assert(t, 2895, len(lines))
+		assert(t, strings.Join(messageHeader, ","), lines[0])
+		assert(t, "2018-11-12 19:57:28,WorldRoundStart,,,,,,,{}", lines[1])
+	})
+
+	t.Run("stats", func(t *testing.T) {
+
+		// given
+		out := &bytes.Buffer{}
+
+		// when
+		err := runStats([]string{exampleLog}, out)
+
+		// then
+		assert(t, nil, err)
+		assert(t, true, strings.HasPrefix(out.String(), "NAME    STEAM_ID           ROUNDS"))
+		assert(t, true, strings.Contains(out.String(), "Player  STEAM_1:1:0101011  17      49"))
+	})
+
+	t.Run("rounds", func(t *testing.T) {
+
+		// given
+		out := &bytes.Buffer{}
+
+		// when
+		err := runRounds([]string{"-format", "csv", exampleLog}, out)
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+
+		// then
+		assert(t, nil, err)
+		assert(t, 18, len(lines))
+		assert(t, "1,live,2018-11-12 19:58:16,108s,TERRORIST,bomb_exploded,0:1,8", lines[1])
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+
+		// when
+		err := runParse([]string{"-format", "xml", exampleLog}, &bytes.Buffer{})
+
+		// then
+		assert(t, true, err != nil)
+	})
+}
+
+func assert(t *testing.T, want interface{}, have interface{}) {
+
+	// mark as test helper function
+	t.Helper()
+
+	if want != have {
+		t.Error("Assertion failed for", t.Name(), "\n\twanted:\t", want, "\n\thave:\t", have)
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/janstuemmel/csgo-log"
+)
+
+// timeFormat is the format of times in CSV and text output
+const timeFormat = "2006-01-02 15:04:05"
+
+// messageHeader is the CSV header of messages, the first two players
+// of a message are flattened and all other fields are kept as JSON
+var messageHeader = []string{
+	"time", "type",
+	"player", "player_steam_id", "player_side",
+	"target", "target_steam_id", "target_side",
+	"data",
+}
+
+// messageWriter writes messages in an output format
+type messageWriter interface {
+	Write(m csgolog.Message) error
+	Flush() error
+}
+
+// newMessageWriter returns a messageWriter for the format
+func newMessageWriter(format string, w io.Writer) (messageWriter, error) {
+
+	switch format {
+	case "json":
+		return jsonWriter{w}, nil
+	case "csv":
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case "text":
+		return textWriter{w}, nil
+	}
+
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+// jsonWriter writes a JSON object per line
+type jsonWriter struct {
+	w io.Writer
+}
+
+func (j jsonWriter) Write(m csgolog.Message) error {
+	_, err := io.WriteString(j.w, csgolog.ToJSON(m))
+	return err
+}
+
+func (j jsonWriter) Flush() error {
+	return nil
+}
+
+// csvWriter writes a CSV record per message after the header
+type csvWriter struct {
+	w      *csv.Writer
+	header bool
+}
+
+func (c *csvWriter) Write(m csgolog.Message) error {
+
+	if !c.header {
+		c.header = true
+		if err := c.w.Write(messageHeader); err != nil {
+			return err
+		}
+	}
+
+	record := []string{m.GetTime().Format(timeFormat), m.GetType()}
+	players := csgolog.Players(m)
+
+	for i := 0; i < 2; i++ {
+		if i < len(players) {
+			p := players[i]
+			record = append(record, p.Name, p.SteamID, p.Side)
+		} else {
+			record = append(record, "", "", "")
+		}
+	}
+
+	return c.w.Write(append(record, data(m)))
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// textWriter writes time, type and the fields of a message per line
+type textWriter struct {
+	w io.Writer
+}
+
+func (t textWriter) Write(m csgolog.Message) error {
+
+	if u, ok := m.(csgolog.Unknown); ok {
+		_, err := fmt.Fprintf(t.w, "%s %s\n", m.GetTime().Format(timeFormat), u.Raw)
+		return err
+	}
+
+	_, err := fmt.Fprintf(t.w, "%s %s %s\n", m.GetTime().Format(timeFormat), m.GetType(), data(m))
+	return err
+}
+
+func (t textWriter) Flush() error {
+	return nil
+}
+
+// data returns the JSON of a message without time and type
+func data(m csgolog.Message) string {
+
+	fields := map[string]json.RawMessage{}
+
+	if err := json.Unmarshal([]byte(csgolog.ToJSON(m)), &fields); err != nil {
+		return ""
+	}
+
+	delete(fields, "time")
+	delete(fields, "type")
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(fields)
+
+	return strings.TrimSpace(buf.String())
+}
+
+// writeTable writes rows as JSON, CSV or an aligned text table,
+// rows holds the values of the header columns and values the
+// records to marshal to JSON
+func writeTable(w io.Writer, format string, header []string, rows [][]string, values interface{}) error {
+
+	switch format {
+
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		return enc.Encode(values)
+
+	case "csv":
+		c := csv.NewWriter(w)
+		c.Write(header)
+		c.WriteAll(rows)
+		return c.Error()
+
+	case "text":
+		return writeText(w, header, rows)
+	}
+
+	return fmt.Errorf("unknown format %q", format)
+}
+
+// writeText writes rows as text table with aligned columns
+func writeText(w io.Writer, header []string, rows [][]string) error {
+
+	widths := make([]int, len(header))
+
+	for _, r := range append([][]string{header}, rows...) {
+		for i, v := range r {
+			if len(v) > widths[i] {
+				widths[i] = len(v)
+			}
+		}
+	}
+
+	for _, r := range append([][]string{upper(header)}, rows...) {
+
+		cols := make([]string, len(r))
+
+		for i, v := range r {
+			cols[i] = v + strings.Repeat(" ", widths[i]-len(v))
+		}
+
+		if _, err := fmt.Fprintln(w, strings.TrimRight(strings.Join(cols, "  "), " ")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// upper returns the values in upper case
+func upper(values []string) []string {
+
+	up := make([]string, len(values))
+
+	for i, v := range values {
+		up[i] = strings.ToUpper(v)
+	}
+
+	return up
+}
@@ -66,6 +66,25 @@ type (
 		Result int `json:"result"`
 	}
 
+	// Property holds a single (key "value") group of a log message
+	Property struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+
+	// Properties holds the (key "value") groups of a log message in
+	// the order they appeared
+	Properties []Property
+
+	// Inventory holds the equipment a player is carrying
+	Inventory struct {
+		Weapons []string `json:"weapons"`
+		Armor   int      `json:"armor"`
+		Helmet  bool     `json:"helmet"`
+		Defuser bool     `json:"defuser"`
+		C4      bool     `json:"c4"`
+	}
+
 	// Message is the interface for all messages
 	Message interface {
 		GetType() string
@@ -84,6 +103,56 @@ type (
 		Text string `json:"text"`
 	}
 
+	// LogFileStarted is received when the server starts a new logfile
+	LogFileStarted struct {
+		Meta
+		File    string     `json:"file"`
+		Game    string     `json:"game"`
+		Version string     `json:"version"`
+		Props   Properties `json:"props,omitempty"`
+	}
+
+	// LogFileClosed is received when the server closes the logfile
+	LogFileClosed struct{ Meta }
+
+	// LoadingMap is received when the server begins loading a map
+	LoadingMap struct {
+		Meta
+		Map string `json:"map"`
+	}
+
+	// StartedMap is received when the server started a map, it starts
+	// a new session of the logfile
+	StartedMap struct {
+		Meta
+		Map   string     `json:"map"`
+		CRC   string     `json:"crc"`
+		Props Properties `json:"props,omitempty"`
+	}
+
+	// ServerCvar is received when the server changes a cvar
+	ServerCvar struct {
+		Meta
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+
+	// CvarDump is received for each cvar the server dumps at map start
+	CvarDump struct {
+		Meta
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+
+	// RconCommand is received when a command is sent via rcon, Bad is
+	// set when the rcon attempt was rejected
+	RconCommand struct {
+		Meta
+		Address string `json:"address"`
+		Command string `json:"command"`
+		Bad     bool   `json:"bad"`
+	}
+
 	// FreezTimeStart is received before each round
 	FreezTimeStart struct{ Meta }
 
@@ -121,10 +190,29 @@ type (
 	// information about which team won the round and the score
 	TeamNotice struct {
 		Meta
-		Side    string `json:"side"`
-		Notice  string `json:"notice"`
-		ScoreCT int    `json:"score_ct"`
-		ScoreT  int    `json:"score_t"`
+		Side    string     `json:"side"`
+		Notice  string     `json:"notice"`
+		ScoreCT int        `json:"score_ct"`
+		ScoreT  int        `json:"score_t"`
+		Props   Properties `json:"props,omitempty"`
+	}
+
+	// MatchStatus is received on competitive servers and holds
+	// the authoritative score of the match
+	MatchStatus struct {
+		Meta
+		ScoreCT      int    `json:"score_ct"`
+		ScoreT       int    `json:"score_t"`
+		Map          string `json:"map"`
+		RoundsPlayed int    `json:"rounds_played"`
+	}
+
+	// TeamPlaying is received on competitive servers and holds
+	// the name of the team playing on a side
+	TeamPlaying struct {
+		Meta
+		Side string `json:"side"`
+		Team string `json:"team"`
 	}
 
 	// PlayerConnected message is received when a player connects and
@@ -139,8 +227,9 @@ type (
 	// holds the reason why the player left
 	PlayerDisconnected struct {
 		Meta
-		Player Player `json:"player"`
-		Reason string `json:"reason"`
+		Player Player     `json:"player"`
+		Reason string     `json:"reason"`
+		Props  Properties `json:"props,omitempty"`
 	}
 
 	// PlayerEntered is received when a player enters the game
@@ -165,6 +254,36 @@ type (
 		To     string `json:"to"`
 	}
 
+	// PlayerValidated is received when the steam id of a player got validated
+	PlayerValidated struct {
+		Meta
+		Player Player `json:"player"`
+	}
+
+	// PlayerJoinedTeam is received when a player joins a team
+	PlayerJoinedTeam struct {
+		Meta
+		Player Player `json:"player"`
+		Team   string `json:"team"`
+	}
+
+	// PlayerNameChanged is received when a player changes the name,
+	// Player holds the old name
+	PlayerNameChanged struct {
+		Meta
+		Player Player `json:"player"`
+		Name   string `json:"name"`
+	}
+
+	// PlayerLeftBuyzone is received when a player leaves the buyzone
+	// and holds the items the player is carrying
+	PlayerLeftBuyzone struct {
+		Meta
+		Player    Player    `json:"player"`
+		Items     []string  `json:"items"`
+		Inventory Inventory `json:"inventory"`
+	}
+
 	// PlayerSay is received when a player writes into chat
 	PlayerSay struct {
 		Meta
@@ -180,7 +299,8 @@ type (
 		Item   string `json:"item"`
 	}
 
-	// PlayerKill is received when a player kills another
+	// PlayerKill is received when a player kills another, Flags holds
+	// all modifiers of the kill, the known ones are set as booleans
 	PlayerKill struct {
 		Meta
 		Attacker         Player   `json:"attacker"`
@@ -190,28 +310,50 @@ type (
 		Weapon           string   `json:"weapon"`
 		Headshot         bool     `json:"headshot"`
 		Penetrated       bool     `json:"penetrated"`
+		Noscope          bool     `json:"noscope"`
+		Throughsmoke     bool     `json:"throughsmoke"`
+		AttackerBlind    bool     `json:"attackerblind"`
+		AttackerInAir    bool     `json:"attackerinair"`
+		Domination       bool     `json:"domination"`
+		Revenge          bool     `json:"revenge"`
+		Flags            []string `json:"flags,omitempty"`
+	}
+
+	// PlayerKillOther is received when a player kills an entity
+	// which is not a player, like a chicken or a hostage
+	PlayerKillOther struct {
+		Meta
+		Attacker         Player   `json:"attacker"`
+		AttackerPosition Position `json:"attacker_pos"`
+		Entity           string   `json:"entity"`
+		Entindex         int      `json:"entindex"`
+		EntityPosition   Position `json:"entity_pos"`
+		Weapon           string   `json:"weapon"`
 	}
 
-	// PlayerKillAssist is received when a player assisted killing another
+	// PlayerKillAssist is received when a player assisted killing another,
+	// Kind is either AssistDamage or AssistFlash
 	PlayerKillAssist struct {
 		Meta
 		Attacker Player `json:"attacker"`
 		Victim   Player `json:"victim"`
+		Kind     string `json:"kind"`
 	}
 
 	// PlayerAttack is recieved when a player attacks another
 	PlayerAttack struct {
 		Meta
-		Attacker         Player   `json:"attacker"`
-		AttackerPosition Position `json:"attacker_pos"`
-		Victim           Player   `json:"victim"`
-		VictimPosition   Position `json:"victim_pos"`
-		Weapon           string   `json:"weapon"`
-		Damage           int      `json:"damage"`
-		DamageArmor      int      `json:"damage_armor"`
-		Health           int      `json:"health"`
-		Armor            int      `json:"armor"`
-		Hitgroup         string   `json:"hitgroup"`
+		Attacker         Player     `json:"attacker"`
+		AttackerPosition Position   `json:"attacker_pos"`
+		Victim           Player     `json:"victim"`
+		VictimPosition   Position   `json:"victim_pos"`
+		Weapon           string     `json:"weapon"`
+		Damage           int        `json:"damage"`
+		DamageArmor      int        `json:"damage_armor"`
+		Health           int        `json:"health"`
+		Armor            int        `json:"armor"`
+		Hitgroup         string     `json:"hitgroup"`
+		Props            Properties `json:"props,omitempty"`
 	}
 
 	// PlayerKilledBomb is received when a player is killed by the bomb
@@ -229,6 +371,16 @@ type (
 		With     string   `json:"with"`
 	}
 
+	// PlayerKilledWorld is received when a player is killed by the world,
+	// e.g. by falling damage
+	PlayerKilledWorld struct {
+		Meta
+		Player   Player   `json:"player"`
+		Position Position `json:"pos"`
+		By       string   `json:"by"`
+		With     string   `json:"with"`
+	}
+
 	// PlayerPickedUp is received when a player picks up an item
 	PlayerPickedUp struct {
 		Meta
@@ -258,10 +410,12 @@ type (
 		Player Player `json:"player"`
 	}
 
-	// PlayerBombPlanted is received when a player plants the bomb
+	// PlayerBombPlanted is received when a player plants the bomb,
+	// Site is only set if the server logs the bombsite
 	PlayerBombPlanted struct {
 		Meta
 		Player Player `json:"player"`
+		Site   string `json:"site"`
 	}
 
 	// PlayerBombDropped is received when a player drops the bomb
@@ -284,6 +438,41 @@ type (
 		Player Player `json:"player"`
 	}
 
+	// PlayerWeaponUpgrade is received in arms race when a player
+	// advances to the next weapon level
+	PlayerWeaponUpgrade struct {
+		Meta
+		Player Player     `json:"player"`
+		Props  Properties `json:"props,omitempty"`
+	}
+
+	// PlayerHostageTouched is received when a player touches a hostage
+	PlayerHostageTouched struct {
+		Meta
+		Player Player `json:"player"`
+	}
+
+	// PlayerHostageRescued is received when a player rescued a hostage
+	PlayerHostageRescued struct {
+		Meta
+		Player Player `json:"player"`
+	}
+
+	// PlayerHostageKilled is received when a player killed a hostage
+	PlayerHostageKilled struct {
+		Meta
+		Player Player `json:"player"`
+	}
+
+	// PlayerTriggered is received when a player triggers an event
+	// that has no dedicated message type
+	PlayerTriggered struct {
+		Meta
+		Player Player            `json:"player"`
+		Event  string            `json:"event"`
+		Props  map[string]string `json:"props"`
+	}
+
 	// PlayerThrew is received when a player threw a grenade
 	PlayerThrew struct {
 		Meta
@@ -309,6 +498,33 @@ type (
 		Velocity Velocity      `json:"velocity"`
 	}
 
+	// GrenadeDetonated is received when a grenade detonates, Entindex
+	// is the one of the PlayerThrew message of the grenade. Detonations
+	// and expiries are logged by some server builds and plugins only
+	GrenadeDetonated struct {
+		Meta
+		Player   Player   `json:"player"`
+		Grenade  string   `json:"grenade"`
+		Position Position `json:"pos"`
+		Entindex int      `json:"entindex"`
+	}
+
+	// InfernoExpired is received when the fire of a molotov or incendiary
+	// grenade burned out or was extinguished, e.g. by a smoke
+	InfernoExpired struct {
+		Meta
+		Entindex     int      `json:"entindex"`
+		Position     Position `json:"pos"`
+		Extinguished bool     `json:"extinguished"`
+	}
+
+	// SmokeExpired is received when the smoke of a smokegrenade faded
+	SmokeExpired struct {
+		Meta
+		Entindex int      `json:"entindex"`
+		Position Position `json:"pos"`
+	}
+
 	// GameOver is received when a team won and the game ends
 	GameOver struct {
 		Meta
@@ -320,6 +536,51 @@ type (
 		Duration int    `json:"duration"`
 	}
 
+	// RoundStats holds the round statistics logged between
+	// JSON_BEGIN{ and }}JSON_END, it is assembled by the Reader
+	RoundStats struct {
+		Meta
+		Name        string             `json:"name"`
+		RoundNumber int                `json:"round_number"`
+		ScoreCT     int                `json:"score_ct"`
+		ScoreT      int                `json:"score_t"`
+		Map         string             `json:"map"`
+		Server      string             `json:"server"`
+		Players     []RoundStatsPlayer `json:"players"`
+	}
+
+	// RoundStatsPlayer holds the statistics of a player in RoundStats,
+	// columns without a field are kept in Extra
+	RoundStatsPlayer struct {
+		AccountID int               `json:"account_id"`
+		Side      string            `json:"side"`
+		Money     int               `json:"money"`
+		Kills     int               `json:"kills"`
+		Deaths    int               `json:"deaths"`
+		Assists   int               `json:"assists"`
+		Damage    int               `json:"damage"`
+		HSP       float32           `json:"hsp"`
+		KDR       float32           `json:"kdr"`
+		ADR       int               `json:"adr"`
+		MVP       int               `json:"mvp"`
+		EF        int               `json:"ef"`
+		UD        int               `json:"ud"`
+		Kills3    int               `json:"3k"`
+		Kills4    int               `json:"4k"`
+		Kills5    int               `json:"5k"`
+		Clutch    int               `json:"clutch_kills"`
+		First     int               `json:"first_kills"`
+		Pistol    int               `json:"pistol_kills"`
+		Sniper    int               `json:"sniper_kills"`
+		Blind     int               `json:"blind_kills"`
+		Bomb      int               `json:"bomb_kills"`
+		Fire      int               `json:"fire_kills"`
+		Unique    int               `json:"unique_kills"`
+		Dinks     int               `json:"dinks"`
+		Chicken   int               `json:"chicken_kills"`
+		Extra     map[string]string `json:"extra,omitempty"`
+	}
+
 	// Unknown holds the raw log message of a message
 	// that is not defined in patterns but starts with time
 	Unknown struct {
@@ -338,15 +599,92 @@ func (m Meta) GetTime() time.Time {
 	return m.Time
 }
 
+// Get returns the value of the first property with the given key
+func (p Properties) Get(key string) (string, bool) {
+
+	for _, prop := range p {
+		if prop.Key == key {
+			return prop.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// Map returns the properties as map, a later key overwrites an earlier one
+func (p Properties) Map() map[string]string {
+
+	m := make(map[string]string, len(p))
+
+	for _, prop := range p {
+		m[prop.Key] = prop.Value
+	}
+
+	return m
+}
+
+// Without returns the properties whose keys are not in the given keys,
+// nil if none are left
+func (p Properties) Without(keys ...string) Properties {
+
+	var rest Properties
+
+	for _, prop := range p {
+		if !contains(keys, prop.Key) {
+			rest = append(rest, prop)
+		}
+	}
+
+	return rest
+}
+
+// PropertyPattern is the regular expression to capture a (key "value") group
+var PropertyPattern = regexp.MustCompile(`\((\w+) "(.*?)"\)`)
+
+// ParseProperties collects all (key "value") groups of a string in order
+func ParseProperties(v string) Properties {
+
+	var props Properties
+
+	for _, p := range PropertyPattern.FindAllStringSubmatch(v, -1) {
+		props = append(props, Property{Key: p[1], Value: p[2]})
+	}
+
+	return props
+}
+
 type MessageFunc func(ti time.Time, r []string) Message
 
+const (
+	// AssistDamage is the kind of an assist by damaging the victim
+	AssistDamage = "damage"
+	// AssistFlash is the kind of an assist by flashing the victim
+	AssistFlash = "flash"
+)
+
 const (
 	// ServerMessagePattern regular expression
 	ServerMessagePattern = `server_message: "(\w+)"`
+	// LogFileStartedPattern regular expression
+	LogFileStartedPattern = `^Log file started(.*)`
+	// LogFileClosedPattern regular expression
+	LogFileClosedPattern = `^Log file closed`
+	// LoadingMapPattern regular expression
+	LoadingMapPattern = `^Loading map "(.+?)"`
+	// StartedMapPattern regular expression
+	StartedMapPattern = `^Started map "(.+?)"(.*)`
+	// ServerCvarPattern regular expression
+	ServerCvarPattern = `server_cvar: "(\w+)" "(.*)"`
+	// CvarDumpPattern regular expression
+	CvarDumpPattern = `^"(\w+)" = "(.*)"`
+	// RconCommandPattern regular expression
+	RconCommandPattern = `rcon from "(.+?)": (command|Bad Rcon:) "(.*)"`
+	// BadRconPattern regular expression
+	BadRconPattern = `^Bad Rcon: "(.*)" from "(.+?)"`
 	// FreezTimeStartPattern regular expression
 	FreezTimeStartPattern = `Starting Freeze period`
 	// WorldMatchStartPattern regular expression
-	WorldMatchStartPattern = `World triggered "Match_Start" on "(\w+)"`
+	WorldMatchStartPattern = `World triggered "Match_Start" on "(.+?)"`
 	// WorldRoundStartPattern regular expression
 	WorldRoundStartPattern = `World triggered "Round_Start"`
 	// WorldRoundRestartPattern regular expression
@@ -358,59 +696,99 @@ const (
 	// TeamScoredPattern regular expression
 	TeamScoredPattern = `Team "(CT|TERRORIST)" scored "(\d+)" with "(\d+)" players`
 	// TeamNoticePattern regular expression
-	TeamNoticePattern = `Team "(CT|TERRORIST)" triggered "(\w+)" \(CT "(\d+)"\) \(T "(\d+)"\)`
+	TeamNoticePattern = `Team "(CT|TERRORIST)" triggered "(\w+)"(.*)`
+	// MatchStatusPattern regular expression
+	MatchStatusPattern = `MatchStatus: Score: (\d+):(\d+) on map "(.+?)" RoundsPlayed: (-?\d+)`
+	// TeamPlayingPattern regular expression
+	TeamPlayingPattern = `MatchStatus: Team playing "(CT|TERRORIST)": (.*)`
 	// PlayerConnectedPattern regular expression
 	PlayerConnectedPattern = `"(.+)<(\d+)><([\w:]+)><>" connected, address "(.*)"`
 	// PlayerDisconnectedPattern regular expression
-	PlayerDisconnectedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned|)>" disconnected \(reason "(.+)"\)`
+	PlayerDisconnectedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned|)>" disconnected(.*)`
 	// PlayerEnteredPattern regular expression
 	PlayerEnteredPattern = `"(.+)<(\d+)><([\w:]+)><>" entered the game`
 	// PlayerBannedPattern regular expression
 	PlayerBannedPattern = `Banid: "(.+)<(\d+)><([\w:]+)><\w*>" was banned "([\w. ]+)" by "(\w+)"`
 	// PlayerSwitchedPattern regular expression
 	PlayerSwitchedPattern = `"(.+)<(\d+)><([\w:]+)>" switched from team <(Unassigned|Spectator|TERRORIST|CT)> to <(Unassigned|Spectator|TERRORIST|CT)>`
+	// PlayerValidatedPattern regular expression
+	PlayerValidatedPattern = `"(.+)<(\d+)><([\w:]+)><>" STEAM USERID validated`
+	// PlayerJoinedTeamPattern regular expression
+	PlayerJoinedTeamPattern = `"(.+)<(\d+)><([\w:]+)>(?:<(TERRORIST|CT|Unassigned|Spectator|)>)?" joined team "(\w+)"`
+	// PlayerNameChangedPattern regular expression
+	PlayerNameChangedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned|Spectator|)>" changed name to "(.*)"`
+	// PlayerLeftBuyzonePattern regular expression
+	PlayerLeftBuyzonePattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" left buyzone with \[ ?(.*?) ?\]`
 	// PlayerSayPattern regular expression
-	PlayerSayPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" say(_team)? "(.*)"`
+	PlayerSayPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" say(_team)? "(.*)"`
 	// PlayerPurchasePattern regular expression
-	PlayerPurchasePattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" purchased "(\w+)"`
+	PlayerPurchasePattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" purchased "(\w+)"`
 	// PlayerKillPattern regular expression
-	PlayerKillPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" \[(-?\d+) (-?\d+) (-?\d+)\] killed "(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" \[(-?\d+) (-?\d+) (-?\d+)\] with "(\w+)" ?(\(?(headshot|penetrated|headshot penetrated)?\))?`
+	PlayerKillPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" \[(-?\d+) (-?\d+) (-?\d+)\] killed "(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" \[(-?\d+) (-?\d+) (-?\d+)\] with "(\w+)"(.*)`
+	// PlayerKillOtherPattern regular expression
+	PlayerKillOtherPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" \[(-?\d+) (-?\d+) (-?\d+)\] killed other "(\w+)<(\d+)>" \[(-?\d+) (-?\d+) (-?\d+)\] with "(\w+)"`
 	// PlayerKillAssistPattern regular expression
-	PlayerKillAssistPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" assisted killing "(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>"`
+	PlayerKillAssistPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" (assisted|flash-assisted) killing "(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>"`
 	// PlayerAttackPattern regular expression
-	PlayerAttackPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" \[(-?\d+) (-?\d+) (-?\d+)\] attacked "(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" \[(-?\d+) (-?\d+) (-?\d+)\] with "(\w+)" \(damage "(\d+)"\) \(damage_armor "(\d+)"\) \(health "(\d+)"\) \(armor "(\d+)"\) \(hitgroup "([\w ]+)"\)`
+	PlayerAttackPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" \[(-?\d+) (-?\d+) (-?\d+)\] attacked "(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" \[(-?\d+) (-?\d+) (-?\d+)\] with "(\w+)"(.*)`
 	// PlayerKilledBombPattern regular expression
-	PlayerKilledBombPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" \[(-?\d+) (-?\d+) (-?\d+)\] was killed by the bomb\.`
+	PlayerKilledBombPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" \[(-?\d+) (-?\d+) (-?\d+)\] was killed by the bomb\.`
 	// PlayerKilledSuicidePattern regular expression
-	PlayerKilledSuicidePattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" \[(-?\d+) (-?\d+) (-?\d+)\] committed suicide with "(.*)"`
+	PlayerKilledSuicidePattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" \[(-?\d+) (-?\d+) (-?\d+)\] committed suicide with "(.*)"`
+	// PlayerKilledWorldPattern regular expression
+	PlayerKilledWorldPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" \[(-?\d+) (-?\d+) (-?\d+)\] was killed by "(\w+)" with "(\w+)"`
 	// PlayerPickedUpPattern regular expression
-	PlayerPickedUpPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" picked up "(\w+)"`
+	PlayerPickedUpPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" picked up "(\w+)"`
 	// PlayerDroppedPattern regular expression
 	PlayerDroppedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" dropped "(\w+)"`
 	// PlayerMoneyChangePattern regular expression
-	PlayerMoneyChangePattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" money change (\d+)\+?(-?\d+) = \$(\d+) \(tracked\)( \(purchase: (\w+)\))?`
+	PlayerMoneyChangePattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" money change (\d+)\+?(-?\d+) = \$(\d+) \(tracked\)( \(purchase: (\w+)\))?`
 	// PlayerBombGotPattern regular expression
-	PlayerBombGotPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" triggered "Got_The_Bomb"`
+	PlayerBombGotPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" triggered "Got_The_Bomb"`
 	// PlayerBombPlantedPattern regular expression
-	PlayerBombPlantedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" triggered "Planted_The_Bomb"`
+	PlayerBombPlantedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" triggered "Planted_The_Bomb"(?: at bombsite (\w+))?`
 	// PlayerBombDroppedPattern regular expression
-	PlayerBombDroppedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" triggered "Dropped_The_Bomb"`
+	PlayerBombDroppedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" triggered "Dropped_The_Bomb"`
 	// PlayerBombBeginDefusePattern regular expression
-	PlayerBombBeginDefusePattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" triggered "Begin_Bomb_Defuse_With(out)?_Kit"`
+	PlayerBombBeginDefusePattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" triggered "Begin_Bomb_Defuse_With(out)?_Kit"`
 	// PlayerBombDefusedPattern regular expression
-	PlayerBombDefusedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" triggered "Defused_The_Bomb"`
+	PlayerBombDefusedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" triggered "Defused_The_Bomb"`
+	// PlayerWeaponUpgradePattern regular expression
+	PlayerWeaponUpgradePattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" triggered "Weapon_Upgrade"(.*)`
+	// PlayerHostageTouchedPattern regular expression
+	PlayerHostageTouchedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" triggered "Touched_A_Hostage"`
+	// PlayerHostageRescuedPattern regular expression
+	PlayerHostageRescuedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" triggered "Rescued_A_Hostage"`
+	// PlayerHostageKilledPattern regular expression
+	PlayerHostageKilledPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" triggered "Killed_A_Hostage"`
+	// PlayerTriggeredPattern regular expression
+	PlayerTriggeredPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned|Spectator|)>" triggered "(\w+)"(.*)`
 	// PlayerThrewPattern regular expression
-	PlayerThrewPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" threw (\w+) \[(-?\d+) (-?\d+) (-?\d+)\]( flashbang entindex (\d+))?\)?`
+	PlayerThrewPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" threw (\w+) \[(-?\d+) (-?\d+) (-?\d+)\]( \w+ entindex (\d+))?\)?`
 	// PlayerBlindedPattern regular expression
-	PlayerBlindedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" blinded for ([\d.]+) by "(.+)<(\d+)><([\w:]+)><(TERRORIST|CT)>" from flashbang entindex (\d+)`
+	PlayerBlindedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" blinded for ([\d.]+) by "(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" from flashbang entindex (\d+)`
 	// ProjectileSpawnedPattern regular expression
 	ProjectileSpawnedPattern = `Molotov projectile spawned at (-?\d+\.\d+) (-?\d+\.\d+) (-?\d+\.\d+), velocity (-?\d+\.\d+) (-?\d+\.\d+) (-?\d+\.\d+)`
+	// GrenadeDetonatedPattern regular expression
+	GrenadeDetonatedPattern = `"(.+)<(\d+)><([\w:]+)><(TERRORIST|CT|Unassigned)>" (\w+) detonated at \[(-?\d+) (-?\d+) (-?\d+)\] entindex (\d+)`
+	// InfernoExpiredPattern regular expression
+	InfernoExpiredPattern = `^inferno entindex (\d+) (expired|extinguished) at \[(-?\d+) (-?\d+) (-?\d+)\]`
+	// SmokeExpiredPattern regular expression
+	SmokeExpiredPattern = `^smokegrenade entindex (\d+) expired at \[(-?\d+) (-?\d+) (-?\d+)\]`
 	// GameOverPattern regular expression
-	GameOverPattern = `Game Over: (\w+) (\w+) (\w+) score (\d+):(\d+) after (\d+) min`
+	GameOverPattern = `Game Over: (\w+) (\S*) (\S+) score (\d+):(\d+) after (\d+) min`
 )
 
 var DefaultPatterns = map[*regexp.Regexp]MessageFunc{
 	regexp.MustCompile(ServerMessagePattern):         NewServerMessage,
+	regexp.MustCompile(LogFileStartedPattern):        NewLogFileStarted,
+	regexp.MustCompile(LogFileClosedPattern):         NewLogFileClosed,
+	regexp.MustCompile(LoadingMapPattern):            NewLoadingMap,
+	regexp.MustCompile(StartedMapPattern):            NewStartedMap,
+	regexp.MustCompile(ServerCvarPattern):            NewServerCvar,
+	regexp.MustCompile(CvarDumpPattern):              NewCvarDump,
+	regexp.MustCompile(RconCommandPattern):           NewRconCommand,
+	regexp.MustCompile(BadRconPattern):               NewBadRcon,
 	regexp.MustCompile(FreezTimeStartPattern):        NewFreezTimeStart,
 	regexp.MustCompile(WorldMatchStartPattern):       NewWorldMatchStart,
 	regexp.MustCompile(WorldRoundStartPattern):       NewWorldRoundStart,
@@ -419,18 +797,26 @@ var DefaultPatterns = map[*regexp.Regexp]MessageFunc{
 	regexp.MustCompile(WorldGameCommencingPattern):   NewWorldGameCommencing,
 	regexp.MustCompile(TeamScoredPattern):            NewTeamScored,
 	regexp.MustCompile(TeamNoticePattern):            NewTeamNotice,
+	regexp.MustCompile(MatchStatusPattern):           NewMatchStatus,
+	regexp.MustCompile(TeamPlayingPattern):           NewTeamPlaying,
 	regexp.MustCompile(PlayerConnectedPattern):       NewPlayerConnected,
 	regexp.MustCompile(PlayerDisconnectedPattern):    NewPlayerDisconnected,
 	regexp.MustCompile(PlayerEnteredPattern):         NewPlayerEntered,
 	regexp.MustCompile(PlayerBannedPattern):          NewPlayerBanned,
 	regexp.MustCompile(PlayerSwitchedPattern):        NewPlayerSwitched,
+	regexp.MustCompile(PlayerValidatedPattern):       NewPlayerValidated,
+	regexp.MustCompile(PlayerJoinedTeamPattern):      NewPlayerJoinedTeam,
+	regexp.MustCompile(PlayerNameChangedPattern):     NewPlayerNameChanged,
+	regexp.MustCompile(PlayerLeftBuyzonePattern):     NewPlayerLeftBuyzone,
 	regexp.MustCompile(PlayerSayPattern):             NewPlayerSay,
 	regexp.MustCompile(PlayerPurchasePattern):        NewPlayerPurchase,
 	regexp.MustCompile(PlayerKillPattern):            NewPlayerKill,
+	regexp.MustCompile(PlayerKillOtherPattern):       NewPlayerKillOther,
 	regexp.MustCompile(PlayerKillAssistPattern):      NewPlayerKillAssist,
 	regexp.MustCompile(PlayerAttackPattern):          NewPlayerAttack,
 	regexp.MustCompile(PlayerKilledBombPattern):      NewPlayerKilledBomb,
 	regexp.MustCompile(PlayerKilledSuicidePattern):   NewPlayerKilledSuicide,
+	regexp.MustCompile(PlayerKilledWorldPattern):     NewPlayerKilledWorld,
 	regexp.MustCompile(PlayerPickedUpPattern):        NewPlayerPickedUp,
 	regexp.MustCompile(PlayerDroppedPattern):         NewPlayerDropped,
 	regexp.MustCompile(PlayerMoneyChangePattern):     NewPlayerMoneyChange,
@@ -439,22 +825,41 @@ var DefaultPatterns = map[*regexp.Regexp]MessageFunc{
 	regexp.MustCompile(PlayerBombDroppedPattern):     NewPlayerBombDropped,
 	regexp.MustCompile(PlayerBombBeginDefusePattern): NewPlayerBombBeginDefuse,
 	regexp.MustCompile(PlayerBombDefusedPattern):     NewPlayerBombDefused,
+	regexp.MustCompile(PlayerWeaponUpgradePattern):   NewPlayerWeaponUpgrade,
+	regexp.MustCompile(PlayerHostageTouchedPattern):  NewPlayerHostageTouched,
+	regexp.MustCompile(PlayerHostageRescuedPattern):  NewPlayerHostageRescued,
+	regexp.MustCompile(PlayerHostageKilledPattern):   NewPlayerHostageKilled,
 	regexp.MustCompile(PlayerThrewPattern):           NewPlayerThrew,
 	regexp.MustCompile(PlayerBlindedPattern):         NewPlayerBlinded,
 	regexp.MustCompile(ProjectileSpawnedPattern):     NewProjectileSpawned,
+	regexp.MustCompile(GrenadeDetonatedPattern):      NewGrenadeDetonated,
+	regexp.MustCompile(InfernoExpiredPattern):        NewInfernoExpired,
+	regexp.MustCompile(SmokeExpiredPattern):          NewSmokeExpired,
 	regexp.MustCompile(GameOverPattern):              NewGameOver,
 }
 
+// FallbackPatterns are checked by Parse when none of the DefaultPatterns
+// matched, they hold generic patterns overlapping with the default ones
+var FallbackPatterns = map[*regexp.Regexp]MessageFunc{
+	regexp.MustCompile(PlayerTriggeredPattern): NewPlayerTriggered,
+}
+
 // Parse parses a plain log message and returns
 // message type or error if there's no match
 func Parse(line string) (Message, error) {
-	return ParseWithPatterns(line, DefaultPatterns)
+	return parse(line, DefaultPatterns, FallbackPatterns)
 }
 
 // Parse attempts to match a plain log message against the map of provided patterns,
 // if the line matches a key from the map, the corresponding MessageFunc is called on the line to
 // parse it into a Message
 func ParseWithPatterns(line string, patterns map[*regexp.Regexp]MessageFunc) (Message, error) {
+	return parse(line, patterns)
+}
+
+// parse checks the pattern maps in the given order, a later map
+// is only checked when no pattern of the maps before matched
+func parse(line string, patterns ...map[*regexp.Regexp]MessageFunc) (Message, error) {
 	// pattern for date, beginning of a log message
 	result := LogLinePattern.FindStringSubmatch(line)
 
@@ -472,9 +877,11 @@ func ParseWithPatterns(line string, patterns map[*regexp.Regexp]MessageFunc) (Me
 	}
 
 	// check all patterns, return if a pattern matches
-	for re, fun := range patterns {
-		if result := re.FindStringSubmatch(result[2]); result != nil {
-			return fun(ti, result), nil
+	for _, p := range patterns {
+		for re, fun := range p {
+			if result := re.FindStringSubmatch(result[2]); result != nil {
+				return fun(ti, result), nil
+			}
 		}
 	}
 
@@ -506,6 +913,80 @@ func NewServerMessage(ti time.Time, r []string) Message {
 	}
 }
 
+func NewLogFileStarted(ti time.Time, r []string) Message {
+
+	props := ParseProperties(r[1])
+	file, _ := props.Get("file")
+	game, _ := props.Get("game")
+	version, _ := props.Get("version")
+
+	return LogFileStarted{
+		Meta:    NewMeta(ti, "LogFileStarted"),
+		File:    file,
+		Game:    game,
+		Version: version,
+		Props:   props.Without("file", "game", "version"),
+	}
+}
+
+func NewLogFileClosed(ti time.Time, r []string) Message {
+	return LogFileClosed{NewMeta(ti, "LogFileClosed")}
+}
+
+func NewLoadingMap(ti time.Time, r []string) Message {
+	return LoadingMap{
+		Meta: NewMeta(ti, "LoadingMap"),
+		Map:  r[1],
+	}
+}
+
+func NewStartedMap(ti time.Time, r []string) Message {
+
+	props := ParseProperties(r[2])
+	crc, _ := props.Get("CRC")
+
+	return StartedMap{
+		Meta:  NewMeta(ti, "StartedMap"),
+		Map:   r[1],
+		CRC:   crc,
+		Props: props.Without("CRC"),
+	}
+}
+
+func NewServerCvar(ti time.Time, r []string) Message {
+	return ServerCvar{
+		Meta:  NewMeta(ti, "ServerCvar"),
+		Name:  r[1],
+		Value: r[2],
+	}
+}
+
+func NewCvarDump(ti time.Time, r []string) Message {
+	return CvarDump{
+		Meta:  NewMeta(ti, "CvarDump"),
+		Name:  r[1],
+		Value: r[2],
+	}
+}
+
+func NewRconCommand(ti time.Time, r []string) Message {
+	return RconCommand{
+		Meta:    NewMeta(ti, "RconCommand"),
+		Address: r[1],
+		Command: r[3],
+		Bad:     r[2] != "command",
+	}
+}
+
+func NewBadRcon(ti time.Time, r []string) Message {
+	return RconCommand{
+		Meta:    NewMeta(ti, "RconCommand"),
+		Address: r[2],
+		Command: r[1],
+		Bad:     true,
+	}
+}
+
 func NewFreezTimeStart(ti time.Time, r []string) Message {
 	return FreezTimeStart{NewMeta(ti, "FreezTimeStart")}
 }
@@ -546,12 +1027,36 @@ func NewTeamScored(ti time.Time, r []string) Message {
 }
 
 func NewTeamNotice(ti time.Time, r []string) Message {
+
+	props := ParseProperties(r[3])
+	ct, _ := props.Get("CT")
+	t, _ := props.Get("T")
+
 	return TeamNotice{
 		Meta:    NewMeta(ti, "TeamNotice"),
 		Side:    r[1],
 		Notice:  r[2],
-		ScoreCT: toInt(r[3]),
-		ScoreT:  toInt(r[4]),
+		ScoreCT: toInt(ct),
+		ScoreT:  toInt(t),
+		Props:   props.Without("CT", "T"),
+	}
+}
+
+func NewMatchStatus(ti time.Time, r []string) Message {
+	return MatchStatus{
+		Meta:         NewMeta(ti, "MatchStatus"),
+		ScoreCT:      toInt(r[1]),
+		ScoreT:       toInt(r[2]),
+		Map:          r[3],
+		RoundsPlayed: toInt(r[4]),
+	}
+}
+
+func NewTeamPlaying(ti time.Time, r []string) Message {
+	return TeamPlaying{
+		Meta: NewMeta(ti, "TeamPlaying"),
+		Side: r[1],
+		Team: r[2],
 	}
 }
 
@@ -569,6 +1074,10 @@ func NewPlayerConnected(ti time.Time, r []string) Message {
 }
 
 func NewPlayerDisconnected(ti time.Time, r []string) Message {
+
+	props := ParseProperties(r[5])
+	reason, _ := props.Get("reason")
+
 	return PlayerDisconnected{
 		Meta: NewMeta(ti, "PlayerDisconnected"),
 		Player: Player{
@@ -577,7 +1086,8 @@ func NewPlayerDisconnected(ti time.Time, r []string) Message {
 			SteamID: r[3],
 			Side:    r[4],
 		},
-		Reason: r[5],
+		Reason: reason,
+		Props:  props.Without("reason"),
 	}
 }
 
@@ -621,6 +1131,58 @@ func NewPlayerSwitched(ti time.Time, r []string) Message {
 	}
 }
 
+func NewPlayerValidated(ti time.Time, r []string) Message {
+	return PlayerValidated{
+		Meta: NewMeta(ti, "PlayerValidated"),
+		Player: Player{
+			Name:    r[1],
+			ID:      toInt(r[2]),
+			SteamID: r[3],
+			Side:    "",
+		},
+	}
+}
+
+func NewPlayerJoinedTeam(ti time.Time, r []string) Message {
+	return PlayerJoinedTeam{
+		Meta: NewMeta(ti, "PlayerJoinedTeam"),
+		Player: Player{
+			Name:    r[1],
+			ID:      toInt(r[2]),
+			SteamID: r[3],
+			Side:    r[4],
+		},
+		Team: r[5],
+	}
+}
+
+func NewPlayerNameChanged(ti time.Time, r []string) Message {
+	return PlayerNameChanged{
+		Meta: NewMeta(ti, "PlayerNameChanged"),
+		Player: Player{
+			Name:    r[1],
+			ID:      toInt(r[2]),
+			SteamID: r[3],
+			Side:    r[4],
+		},
+		Name: r[5],
+	}
+}
+
+func NewPlayerLeftBuyzone(ti time.Time, r []string) Message {
+	return PlayerLeftBuyzone{
+		Meta: NewMeta(ti, "PlayerLeftBuyzone"),
+		Player: Player{
+			Name:    r[1],
+			ID:      toInt(r[2]),
+			SteamID: r[3],
+			Side:    r[4],
+		},
+		Items:     strings.Fields(r[5]),
+		Inventory: toInventory(strings.Fields(r[5])),
+	}
+}
+
 func NewPlayerSay(ti time.Time, r []string) Message {
 	return PlayerSay{
 		Meta: NewMeta(ti, "PlayerSay"),
@@ -649,6 +1211,9 @@ func NewPlayerPurchase(ti time.Time, r []string) Message {
 }
 
 func NewPlayerKill(ti time.Time, r []string) Message {
+
+	flags := toFlags(r[16])
+
 	return PlayerKill{
 		Meta: NewMeta(ti, "PlayerKill"),
 		Attacker: Player{
@@ -673,13 +1238,52 @@ func NewPlayerKill(ti time.Time, r []string) Message {
 			Y: toInt(r[13]),
 			Z: toInt(r[14]),
 		},
-		Weapon:     r[15],
-		Headshot:   strings.Contains(r[17], "headshot"),
-		Penetrated: strings.Contains(r[17], "penetrated"),
+		Weapon:        r[15],
+		Headshot:      contains(flags, "headshot"),
+		Penetrated:    contains(flags, "penetrated"),
+		Noscope:       contains(flags, "noscope"),
+		Throughsmoke:  contains(flags, "throughsmoke"),
+		AttackerBlind: contains(flags, "attackerblind"),
+		AttackerInAir: contains(flags, "attackerinair"),
+		Domination:    contains(flags, "domination"),
+		Revenge:       contains(flags, "revenge"),
+		Flags:         flags,
+	}
+}
+
+func NewPlayerKillOther(ti time.Time, r []string) Message {
+	return PlayerKillOther{
+		Meta: NewMeta(ti, "PlayerKillOther"),
+		Attacker: Player{
+			Name:    r[1],
+			ID:      toInt(r[2]),
+			SteamID: r[3],
+			Side:    r[4],
+		},
+		AttackerPosition: Position{
+			X: toInt(r[5]),
+			Y: toInt(r[6]),
+			Z: toInt(r[7]),
+		},
+		Entity:   r[8],
+		Entindex: toInt(r[9]),
+		EntityPosition: Position{
+			X: toInt(r[10]),
+			Y: toInt(r[11]),
+			Z: toInt(r[12]),
+		},
+		Weapon: r[13],
 	}
 }
 
 func NewPlayerKillAssist(ti time.Time, r []string) Message {
+
+	kind := AssistDamage
+
+	if r[5] == "flash-assisted" {
+		kind = AssistFlash
+	}
+
 	return PlayerKillAssist{
 		Meta: NewMeta(ti, "PlayerKillAssist"),
 		Attacker: Player{
@@ -689,15 +1293,24 @@ func NewPlayerKillAssist(ti time.Time, r []string) Message {
 			Side:    r[4],
 		},
 		Victim: Player{
-			Name:    r[5],
-			ID:      toInt(r[6]),
-			SteamID: r[7],
-			Side:    r[8],
+			Name:    r[6],
+			ID:      toInt(r[7]),
+			SteamID: r[8],
+			Side:    r[9],
 		},
+		Kind: kind,
 	}
 }
 
 func NewPlayerAttack(ti time.Time, r []string) Message {
+
+	props := ParseProperties(r[16])
+	damage, _ := props.Get("damage")
+	damageArmor, _ := props.Get("damage_armor")
+	health, _ := props.Get("health")
+	armor, _ := props.Get("armor")
+	hitgroup, _ := props.Get("hitgroup")
+
 	return PlayerAttack{
 		Meta: NewMeta(ti, "PlayerAttack"),
 		Attacker: Player{
@@ -723,11 +1336,12 @@ func NewPlayerAttack(ti time.Time, r []string) Message {
 			Z: toInt(r[14]),
 		},
 		Weapon:      r[15],
-		Damage:      toInt(r[16]),
-		DamageArmor: toInt(r[17]),
-		Health:      toInt(r[18]),
-		Armor:       toInt(r[19]),
-		Hitgroup:    r[20],
+		Damage:      toInt(damage),
+		DamageArmor: toInt(damageArmor),
+		Health:      toInt(health),
+		Armor:       toInt(armor),
+		Hitgroup:    hitgroup,
+		Props:       props.Without("damage", "damage_armor", "health", "armor", "hitgroup"),
 	}
 }
 
@@ -766,6 +1380,25 @@ func NewPlayerKilledSuicide(ti time.Time, r []string) Message {
 	}
 }
 
+func NewPlayerKilledWorld(ti time.Time, r []string) Message {
+	return PlayerKilledWorld{
+		Meta: NewMeta(ti, "PlayerKilledWorld"),
+		Player: Player{
+			Name:    r[1],
+			ID:      toInt(r[2]),
+			SteamID: r[3],
+			Side:    r[4],
+		},
+		Position: Position{
+			X: toInt(r[5]),
+			Y: toInt(r[6]),
+			Z: toInt(r[7]),
+		},
+		By:   r[8],
+		With: r[9],
+	}
+}
+
 func NewPlayerPickedUp(ti time.Time, r []string) Message {
 	return PlayerPickedUp{
 		Meta: NewMeta(ti, "PlayerPickedUp"),
@@ -831,6 +1464,7 @@ func NewPlayerBombPlanted(ti time.Time, r []string) Message {
 			SteamID: r[3],
 			Side:    r[4],
 		},
+		Site: r[5],
 	}
 }
 
@@ -871,6 +1505,69 @@ func NewPlayerBombDefused(ti time.Time, r []string) Message {
 	}
 }
 
+func NewPlayerWeaponUpgrade(ti time.Time, r []string) Message {
+	return PlayerWeaponUpgrade{
+		Meta: NewMeta(ti, "PlayerWeaponUpgrade"),
+		Player: Player{
+			Name:    r[1],
+			ID:      toInt(r[2]),
+			SteamID: r[3],
+			Side:    r[4],
+		},
+		Props: ParseProperties(r[5]),
+	}
+}
+
+func NewPlayerHostageTouched(ti time.Time, r []string) Message {
+	return PlayerHostageTouched{
+		Meta: NewMeta(ti, "PlayerHostageTouched"),
+		Player: Player{
+			Name:    r[1],
+			ID:      toInt(r[2]),
+			SteamID: r[3],
+			Side:    r[4],
+		},
+	}
+}
+
+func NewPlayerHostageRescued(ti time.Time, r []string) Message {
+	return PlayerHostageRescued{
+		Meta: NewMeta(ti, "PlayerHostageRescued"),
+		Player: Player{
+			Name:    r[1],
+			ID:      toInt(r[2]),
+			SteamID: r[3],
+			Side:    r[4],
+		},
+	}
+}
+
+func NewPlayerHostageKilled(ti time.Time, r []string) Message {
+	return PlayerHostageKilled{
+		Meta: NewMeta(ti, "PlayerHostageKilled"),
+		Player: Player{
+			Name:    r[1],
+			ID:      toInt(r[2]),
+			SteamID: r[3],
+			Side:    r[4],
+		},
+	}
+}
+
+func NewPlayerTriggered(ti time.Time, r []string) Message {
+	return PlayerTriggered{
+		Meta: NewMeta(ti, "PlayerTriggered"),
+		Player: Player{
+			Name:    r[1],
+			ID:      toInt(r[2]),
+			SteamID: r[3],
+			Side:    r[4],
+		},
+		Event: r[5],
+		Props: ParseProperties(r[6]).Map(),
+	}
+}
+
 func NewPlayerThrew(ti time.Time, r []string) Message {
 	return PlayerThrew{
 		Meta: NewMeta(ti, "PlayerThrew"),
@@ -926,6 +1623,50 @@ func NewProjectileSpawned(ti time.Time, r []string) Message {
 	}
 }
 
+func NewGrenadeDetonated(ti time.Time, r []string) Message {
+	return GrenadeDetonated{
+		Meta: NewMeta(ti, "GrenadeDetonated"),
+		Player: Player{
+			Name:    r[1],
+			ID:      toInt(r[2]),
+			SteamID: r[3],
+			Side:    r[4],
+		},
+		Grenade: r[5],
+		Position: Position{
+			X: toInt(r[6]),
+			Y: toInt(r[7]),
+			Z: toInt(r[8]),
+		},
+		Entindex: toInt(r[9]),
+	}
+}
+
+func NewInfernoExpired(ti time.Time, r []string) Message {
+	return InfernoExpired{
+		Meta:     NewMeta(ti, "InfernoExpired"),
+		Entindex: toInt(r[1]),
+		Position: Position{
+			X: toInt(r[3]),
+			Y: toInt(r[4]),
+			Z: toInt(r[5]),
+		},
+		Extinguished: r[2] == "extinguished",
+	}
+}
+
+func NewSmokeExpired(ti time.Time, r []string) Message {
+	return SmokeExpired{
+		Meta:     NewMeta(ti, "SmokeExpired"),
+		Entindex: toInt(r[1]),
+		Position: Position{
+			X: toInt(r[2]),
+			Y: toInt(r[3]),
+			Z: toInt(r[4]),
+		},
+	}
+}
+
 func NewGameOver(ti time.Time, r []string) Message {
 	return GameOver{
 		Meta:     NewMeta(ti, "GameOver"),
@@ -959,6 +1700,64 @@ func toInt(v string) int {
 	return i
 }
 
+// armorPattern captures the armor value of a kevlar(100) item
+var armorPattern = regexp.MustCompile(`^kevlar\((\d+)\)$`)
+
+// toInventory sorts the items of a buyzone line into an inventory,
+// weapons are stored without the weapon_ prefix
+func toInventory(items []string) Inventory {
+
+	inv := Inventory{Weapons: []string{}}
+
+	for _, item := range items {
+
+		if r := armorPattern.FindStringSubmatch(item); r != nil {
+			inv.Armor = toInt(r[1])
+			continue
+		}
+
+		switch strings.ToLower(item) {
+		case "helmet":
+			inv.Helmet = true
+		case "defuser":
+			inv.Defuser = true
+		case "c4", "weapon_c4":
+			inv.C4 = true
+		default:
+			inv.Weapons = append(inv.Weapons, strings.TrimPrefix(item, "weapon_"))
+		}
+	}
+
+	return inv
+}
+
+// flagsPattern captures a group of modifiers like (headshot penetrated)
+var flagsPattern = regexp.MustCompile(`\(([\w ]+)\)`)
+
+// toFlags collects the modifiers of all groups of a string in order
+func toFlags(v string) []string {
+
+	var flags []string
+
+	for _, f := range flagsPattern.FindAllStringSubmatch(v, -1) {
+		flags = append(flags, strings.Fields(f[1])...)
+	}
+
+	return flags
+}
+
+// contains reports whether v is in list
+func contains(list []string, v string) bool {
+
+	for _, l := range list {
+		if l == v {
+			return true
+		}
+	}
+
+	return false
+}
+
 func toFloat32(v string) float32 {
 
 	i, err := strconv.ParseFloat(v, 32)
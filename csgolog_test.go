@@ -88,6 +88,181 @@ func TestMessages(t *testing.T) {
 		assert(t, "quit", sm.Text)
 	})
 
+	t.Run("LogFileStarted", func(t *testing.T) {
+
+		// given
+		l := line(`Log file started (file "logs/L000_000_000_000_27015_201811121957_000.log") (game "/home/steam/csgo/csgo") (version "7340")`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "LogFileStarted", m.GetType())
+
+		// when
+		ls, ok := m.(LogFileStarted)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "logs/L000_000_000_000_27015_201811121957_000.log", ls.File)
+		assert(t, "/home/steam/csgo/csgo", ls.Game)
+		assert(t, "7340", ls.Version)
+		assert(t, 0, len(ls.Props))
+	})
+
+	t.Run("LogFileClosed", func(t *testing.T) {
+
+		// given
+		l := line(`Log file closed`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "LogFileClosed", m.GetType())
+
+		// when
+		_, ok := m.(LogFileClosed)
+
+		// then
+		assert(t, true, ok)
+	})
+
+	t.Run("LoadingMap", func(t *testing.T) {
+
+		// given
+		l := line(`Loading map "workshop/125438255/de_cache"`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "LoadingMap", m.GetType())
+
+		// when
+		lm, ok := m.(LoadingMap)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "workshop/125438255/de_cache", lm.Map)
+	})
+
+	t.Run("StartedMap", func(t *testing.T) {
+
+		// given
+		l := line(`Started map "de_cache" (CRC "-1592476112")`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "StartedMap", m.GetType())
+
+		// when
+		sm, ok := m.(StartedMap)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "de_cache", sm.Map)
+		assert(t, "-1592476112", sm.CRC)
+	})
+
+	t.Run("ServerCvar", func(t *testing.T) {
+
+		// given
+		l := line(`server_cvar: "mp_maxrounds" "30"`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "ServerCvar", m.GetType())
+
+		// when
+		sc, ok := m.(ServerCvar)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "mp_maxrounds", sc.Name)
+		assert(t, "30", sc.Value)
+	})
+
+	t.Run("CvarDump", func(t *testing.T) {
+
+		// given
+		l := line(`"mp_freezetime" = "15"`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "CvarDump", m.GetType())
+
+		// when
+		cd, ok := m.(CvarDump)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "mp_freezetime", cd.Name)
+		assert(t, "15", cd.Value)
+	})
+
+	t.Run("RconCommand", func(t *testing.T) {
+
+		// given
+		l := line(`rcon from "1.2.3.4:5678": command "mp_restartgame 1"`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "RconCommand", m.GetType())
+
+		// when
+		rc, ok := m.(RconCommand)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "1.2.3.4:5678", rc.Address)
+		assert(t, "mp_restartgame 1", rc.Command)
+		assert(t, false, rc.Bad)
+	})
+
+	t.Run("RconCommand Bad", func(t *testing.T) {
+
+		// given
+		lines := []string{
+			line(`rcon from "1.2.3.4:5678": Bad Rcon: "rcon 1234 "secret" status"`),
+			line(`Bad Rcon: "rcon 1234 "secret" status" from "1.2.3.4:5678"`),
+		}
+
+		for _, l := range lines {
+
+			// when
+			m, err := Parse(l)
+
+			// then
+			assert(t, nil, err)
+			assert(t, "RconCommand", m.GetType())
+
+			// when
+			rc, ok := m.(RconCommand)
+
+			// then
+			assert(t, true, ok)
+			assert(t, "1.2.3.4:5678", rc.Address)
+			assert(t, `rcon 1234 "secret" status`, rc.Command)
+			assert(t, true, rc.Bad)
+		}
+	})
+
 	t.Run("FreezTimeStart", func(t *testing.T) {
 
 		// given
@@ -273,6 +448,74 @@ func TestMessages(t *testing.T) {
 		assert(t, 0, tn.ScoreT)
 	})
 
+	t.Run("TeamNotice Reordered", func(t *testing.T) {
+
+		// given
+		l := line(`Team "TERRORIST" triggered "SFUI_Notice_Target_Bombed" (T "5") (CT "3") (foo "bar")`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "TeamNotice", m.GetType())
+
+		// when
+		tn, ok := m.(TeamNotice)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "TERRORIST", tn.Side)
+		assert(t, 3, tn.ScoreCT)
+		assert(t, 5, tn.ScoreT)
+		assert(t, 1, len(tn.Props))
+		assert(t, Property{"foo", "bar"}, tn.Props[0])
+	})
+
+	t.Run("MatchStatus", func(t *testing.T) {
+
+		// given
+		l := line(`MatchStatus: Score: 3:5 on map "de_mirage" RoundsPlayed: 8`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "MatchStatus", m.GetType())
+
+		// when
+		ms, ok := m.(MatchStatus)
+
+		// then
+		assert(t, true, ok)
+		assert(t, 3, ms.ScoreCT)
+		assert(t, 5, ms.ScoreT)
+		assert(t, "de_mirage", ms.Map)
+		assert(t, 8, ms.RoundsPlayed)
+	})
+
+	t.Run("TeamPlaying", func(t *testing.T) {
+
+		// given
+		l := line(`MatchStatus: Team playing "CT": Team Liquid`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "TeamPlaying", m.GetType())
+
+		// when
+		tp, ok := m.(TeamPlaying)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "CT", tp.Side)
+		assert(t, "Team Liquid", tp.Team)
+	})
+
 	t.Run("PlayerConnected", func(t *testing.T) {
 
 		// given
@@ -365,6 +608,155 @@ func TestMessages(t *testing.T) {
 		assert(t, "Spectator", ps.To)
 	})
 
+	t.Run("PlayerValidated", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><>" STEAM USERID validated`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "PlayerValidated", m.GetType())
+
+		// when
+		pv, ok := m.(PlayerValidated)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "Player-Name", pv.Player.Name)
+		assert(t, 12, pv.Player.ID)
+		assert(t, "STEAM_1:1:0101011", pv.Player.SteamID)
+	})
+
+	t.Run("PlayerJoinedTeam", func(t *testing.T) {
+
+		// given
+		lines := []string{
+			line(`"Player-Name<12><STEAM_1:1:0101011>" joined team "CT"`),
+			line(`"Player-Name<12><STEAM_1:1:0101011><Unassigned>" joined team "CT"`),
+		}
+
+		for _, l := range lines {
+
+			// when
+			m, err := Parse(l)
+
+			// then
+			assert(t, nil, err)
+			assert(t, "PlayerJoinedTeam", m.GetType())
+
+			// when
+			pj, ok := m.(PlayerJoinedTeam)
+
+			// then
+			assert(t, true, ok)
+			assert(t, "Player-Name", pj.Player.Name)
+			assert(t, 12, pj.Player.ID)
+			assert(t, "STEAM_1:1:0101011", pj.Player.SteamID)
+			assert(t, "CT", pj.Team)
+		}
+	})
+
+	t.Run("PlayerNameChanged", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><CT>" changed name to "New Name"`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "PlayerNameChanged", m.GetType())
+
+		// when
+		pn, ok := m.(PlayerNameChanged)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "Player-Name", pn.Player.Name)
+		assert(t, 12, pn.Player.ID)
+		assert(t, "STEAM_1:1:0101011", pn.Player.SteamID)
+		assert(t, "CT", pn.Player.Side)
+		assert(t, "New Name", pn.Name)
+	})
+
+	t.Run("PlayerLeftBuyzone", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><CT>" left buyzone with [ weapon_knife weapon_usp_silencer kevlar(100) ]`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "PlayerLeftBuyzone", m.GetType())
+
+		// when
+		pl, ok := m.(PlayerLeftBuyzone)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "Player-Name", pl.Player.Name)
+		assert(t, "CT", pl.Player.Side)
+		assert(t, 3, len(pl.Items))
+		assert(t, "weapon_knife", pl.Items[0])
+		assert(t, "kevlar(100)", pl.Items[2])
+		assert(t, 2, len(pl.Inventory.Weapons))
+		assert(t, 100, pl.Inventory.Armor)
+	})
+
+	t.Run("PlayerLeftBuyzone Inventory", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><TERRORIST>" left buyzone with [ weapon_knife weapon_glock weapon_ak47 weapon_flashbang kevlar(97) helmet C4 ]`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+
+		// when
+		pl, ok := m.(PlayerLeftBuyzone)
+
+		// then
+		assert(t, true, ok)
+		assert(t, 4, len(pl.Inventory.Weapons))
+		assert(t, "knife", pl.Inventory.Weapons[0])
+		assert(t, "glock", pl.Inventory.Weapons[1])
+		assert(t, "ak47", pl.Inventory.Weapons[2])
+		assert(t, "flashbang", pl.Inventory.Weapons[3])
+		assert(t, 97, pl.Inventory.Armor)
+		assert(t, true, pl.Inventory.Helmet)
+		assert(t, false, pl.Inventory.Defuser)
+		assert(t, true, pl.Inventory.C4)
+	})
+
+	t.Run("PlayerLeftBuyzone Empty", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><CT>" left buyzone with [ ]`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+
+		// when
+		pl, ok := m.(PlayerLeftBuyzone)
+
+		// then
+		assert(t, true, ok)
+		assert(t, 0, len(pl.Items))
+		assert(t, 0, len(pl.Inventory.Weapons))
+		assert(t, 0, pl.Inventory.Armor)
+	})
+
 	t.Run("PlayerSay", func(t *testing.T) {
 
 		// given
@@ -467,6 +859,116 @@ func TestMessages(t *testing.T) {
 		assert(t, true, pk.Penetrated)
 	})
 
+	t.Run("PlayerKill Modifiers", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><TERRORIST>" [-225 -1829 -168] killed "Zim<20><BOT><CT>" [-476 -1709 -110] with "awp" (attackerblind headshot noscope penetrated throughsmoke) (attackerinair) (domination)`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "PlayerKill", m.GetType())
+
+		// when
+		pk, ok := m.(PlayerKill)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "awp", pk.Weapon)
+		assert(t, true, pk.Headshot)
+		assert(t, true, pk.Penetrated)
+		assert(t, true, pk.Noscope)
+		assert(t, true, pk.Throughsmoke)
+		assert(t, true, pk.AttackerBlind)
+		assert(t, true, pk.AttackerInAir)
+		assert(t, true, pk.Domination)
+		assert(t, false, pk.Revenge)
+		assert(t, 7, len(pk.Flags))
+		assert(t, "attackerblind", pk.Flags[0])
+		assert(t, "domination", pk.Flags[6])
+	})
+
+	t.Run("PlayerKill Revenge", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><TERRORIST>" [-225 -1829 -168] killed "Zim<20><BOT><CT>" [-476 -1709 -110] with "ak47" (revenge)`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+
+		// when
+		pk, ok := m.(PlayerKill)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "ak47", pk.Weapon)
+		assert(t, false, pk.Headshot)
+		assert(t, true, pk.Revenge)
+		assert(t, 1, len(pk.Flags))
+	})
+
+	t.Run("PlayerKillOther", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><CT>" [-225 -1829 -168] killed other "chicken<123>" [-476 -1709 -110] with "ak47"`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "PlayerKillOther", m.GetType())
+
+		// when
+		pk, ok := m.(PlayerKillOther)
+
+		// then
+		assert(t, true, ok)
+
+		assert(t, "Player-Name", pk.Attacker.Name)
+		assert(t, 12, pk.Attacker.ID)
+		assert(t, "CT", pk.Attacker.Side)
+
+		assert(t, -225, pk.AttackerPosition.X)
+		assert(t, -1829, pk.AttackerPosition.Y)
+		assert(t, -168, pk.AttackerPosition.Z)
+
+		assert(t, "chicken", pk.Entity)
+		assert(t, 123, pk.Entindex)
+
+		assert(t, -476, pk.EntityPosition.X)
+		assert(t, -1709, pk.EntityPosition.Y)
+		assert(t, -110, pk.EntityPosition.Z)
+
+		assert(t, "ak47", pk.Weapon)
+	})
+
+	t.Run("PlayerKillOther Hostage", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><TERRORIST>" [-225 -1829 -168] killed other "hostage_entity<77>" [-476 -1709 -110] with "glock"`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "PlayerKillOther", m.GetType())
+
+		// when
+		pk, ok := m.(PlayerKillOther)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "hostage_entity", pk.Entity)
+		assert(t, 77, pk.Entindex)
+	})
+
 	t.Run("PlayerKillAssist", func(t *testing.T) {
 
 		// given
@@ -492,6 +994,37 @@ func TestMessages(t *testing.T) {
 		assert(t, "Player-Name", pk.Victim.Name)
 		assert(t, 12, pk.Victim.ID)
 		assert(t, "STEAM_1:1:0101011", pk.Victim.SteamID)
+
+		assert(t, AssistDamage, pk.Kind)
+	})
+
+	t.Run("PlayerKillAssist Flash", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<10><STEAM_1:1:0101010><CT>" flash-assisted killing "Zim<20><BOT><TERRORIST>"`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "PlayerKillAssist", m.GetType())
+
+		// when
+		pk, ok := m.(PlayerKillAssist)
+
+		// then
+		assert(t, true, ok)
+
+		assert(t, "Player-Name", pk.Attacker.Name)
+		assert(t, 10, pk.Attacker.ID)
+		assert(t, "CT", pk.Attacker.Side)
+
+		assert(t, "Zim", pk.Victim.Name)
+		assert(t, 20, pk.Victim.ID)
+		assert(t, "TERRORIST", pk.Victim.Side)
+
+		assert(t, AssistFlash, pk.Kind)
 	})
 
 	t.Run("PlayerAttack", func(t *testing.T) {
@@ -534,6 +1067,33 @@ func TestMessages(t *testing.T) {
 		assert(t, 73, pa.Health)
 		assert(t, 96, pa.Armor)
 		assert(t, "chest", pa.Hitgroup)
+		assert(t, 0, len(pa.Props))
+	})
+
+	t.Run("PlayerAttack Extra Props", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<2><STEAM_1:1:0101011><TERRORIST>" [480 -67 1782] attacked "Jon<9><BOT><CT>" [-134 362 1613] with "ak47" (damage "27") (damage_armor "3") (health "73") (armor "96") (hitgroup "left leg") (penetrated "1")`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "PlayerAttack", m.GetType())
+
+		// when
+		pa, ok := m.(PlayerAttack)
+
+		// then
+		assert(t, true, ok)
+		assert(t, 27, pa.Damage)
+		assert(t, 3, pa.DamageArmor)
+		assert(t, 73, pa.Health)
+		assert(t, 96, pa.Armor)
+		assert(t, "left leg", pa.Hitgroup)
+		assert(t, 1, len(pa.Props))
+		assert(t, Property{"penetrated", "1"}, pa.Props[0])
 	})
 
 	t.Run("PlayerKilledBomb", func(t *testing.T) {
@@ -549,7 +1109,34 @@ func TestMessages(t *testing.T) {
 		assert(t, "PlayerKilledBomb", m.GetType())
 
 		// when
-		pk, ok := m.(PlayerKilledBomb)
+		pk, ok := m.(PlayerKilledBomb)
+
+		// then
+		assert(t, true, ok)
+
+		assert(t, "Player-Name", pk.Player.Name)
+		assert(t, 2, pk.Player.ID)
+		assert(t, "STEAM_1:1:0101011", pk.Player.SteamID)
+
+		assert(t, 480, pk.Position.X)
+		assert(t, -67, pk.Position.Y)
+		assert(t, 1782, pk.Position.Z)
+	})
+
+	t.Run("PlayerKilledSuicide", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<2><STEAM_1:1:0101011><TERRORIST>" [480 -67 1782] committed suicide with "hegrenade"`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "PlayerKilledSuicide", m.GetType())
+
+		// when
+		pk, ok := m.(PlayerKilledSuicide)
 
 		// then
 		assert(t, true, ok)
@@ -561,22 +1148,24 @@ func TestMessages(t *testing.T) {
 		assert(t, 480, pk.Position.X)
 		assert(t, -67, pk.Position.Y)
 		assert(t, 1782, pk.Position.Z)
+
+		assert(t, "hegrenade", pk.With)
 	})
 
-	t.Run("PlayerKilledSuicide", func(t *testing.T) {
+	t.Run("PlayerKilledWorld", func(t *testing.T) {
 
 		// given
-		l := line(`"Player-Name<2><STEAM_1:1:0101011><TERRORIST>" [480 -67 1782] committed suicide with "hegrenade"`)
+		l := line(`"Player-Name<2><STEAM_1:1:0101011><TERRORIST>" [480 -67 1782] was killed by "world" with "world"`)
 
 		// when
 		m, err := Parse(l)
 
 		// then
 		assert(t, nil, err)
-		assert(t, "PlayerKilledSuicide", m.GetType())
+		assert(t, "PlayerKilledWorld", m.GetType())
 
 		// when
-		pk, ok := m.(PlayerKilledSuicide)
+		pk, ok := m.(PlayerKilledWorld)
 
 		// then
 		assert(t, true, ok)
@@ -589,7 +1178,8 @@ func TestMessages(t *testing.T) {
 		assert(t, -67, pk.Position.Y)
 		assert(t, 1782, pk.Position.Z)
 
-		assert(t, "hegrenade", pk.With)
+		assert(t, "world", pk.By)
+		assert(t, "world", pk.With)
 	})
 
 	t.Run("PlayerPickedUp", func(t *testing.T) {
@@ -717,6 +1307,27 @@ func TestMessages(t *testing.T) {
 		}
 	})
 
+	t.Run("PlayerBombPlanted Bombsite", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<2><STEAM_1:1:0101011><TERRORIST>" triggered "Planted_The_Bomb" at bombsite B`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "PlayerBombPlanted", m.GetType())
+
+		// when
+		pb, ok := m.(PlayerBombPlanted)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "Player-Name", pb.Player.Name)
+		assert(t, "B", pb.Site)
+	})
+
 	t.Run("PlayerBomb CT", func(t *testing.T) {
 
 		// given
@@ -784,6 +1395,109 @@ func TestMessages(t *testing.T) {
 		assert(t, false, pb.Kit)
 	})
 
+	t.Run("PlayerHostage", func(t *testing.T) {
+
+		// given
+		lines := map[string]string{
+			"PlayerHostageTouched": line(`"Player-Name<2><STEAM_1:1:0101011><CT>" triggered "Touched_A_Hostage"`),
+			"PlayerHostageRescued": line(`"Player-Name<2><STEAM_1:1:0101011><CT>" triggered "Rescued_A_Hostage"`),
+			"PlayerHostageKilled":  line(`"Player-Name<2><STEAM_1:1:0101011><TERRORIST>" triggered "Killed_A_Hostage"`),
+		}
+
+		for msg, l := range lines {
+
+			// when
+			m, err := Parse(l)
+
+			// then
+			assert(t, nil, err)
+			assert(t, msg, m.GetType())
+		}
+	})
+
+	t.Run("PlayerHostageRescued", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<2><STEAM_1:1:0101011><CT>" triggered "Rescued_A_Hostage"`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+
+		// when
+		ph, ok := m.(PlayerHostageRescued)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "Player-Name", ph.Player.Name)
+		assert(t, 2, ph.Player.ID)
+		assert(t, "STEAM_1:1:0101011", ph.Player.SteamID)
+		assert(t, "CT", ph.Player.Side)
+	})
+
+	t.Run("PlayerTriggered", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><TERRORIST>" triggered "clantag" (value "FOO")`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "PlayerTriggered", m.GetType())
+
+		// when
+		pt, ok := m.(PlayerTriggered)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "Player-Name", pt.Player.Name)
+		assert(t, 12, pt.Player.ID)
+		assert(t, "STEAM_1:1:0101011", pt.Player.SteamID)
+		assert(t, "TERRORIST", pt.Player.Side)
+		assert(t, "clantag", pt.Event)
+		assert(t, "FOO", pt.Props["value"])
+	})
+
+	t.Run("PlayerTriggered Without Props", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><TERRORIST>" triggered "Begin_Bomb_Plant"`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "PlayerTriggered", m.GetType())
+
+		// when
+		pt, ok := m.(PlayerTriggered)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "Begin_Bomb_Plant", pt.Event)
+		assert(t, 0, len(pt.Props))
+	})
+
+	t.Run("PlayerTriggered Fallback", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><TERRORIST>" triggered "Planted_The_Bomb"`)
+
+		// when
+		for i := 0; i < 20; i++ {
+			m, err := Parse(l)
+
+			// then
+			assert(t, nil, err)
+			assert(t, "PlayerBombPlanted", m.GetType())
+		}
+	})
+
 	t.Run("PlayerThrew", func(t *testing.T) {
 
 		// given
@@ -844,6 +1558,100 @@ func TestMessages(t *testing.T) {
 		assert(t, -170, pt.Position.Z)
 	})
 
+	t.Run("PlayerThrew Entindex", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><TERRORIST>" threw hegrenade [-716 -1636 -170] hegrenade entindex 170)`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+
+		// when
+		pt, ok := m.(PlayerThrew)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "hegrenade", pt.Grenade)
+		assert(t, 170, pt.Entindex)
+	})
+
+	t.Run("GrenadeDetonated", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><TERRORIST>" smokegrenade detonated at [-300 -1200 -160] entindex 163`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "GrenadeDetonated", m.GetType())
+
+		// when
+		gd, ok := m.(GrenadeDetonated)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "Player-Name", gd.Player.Name)
+		assert(t, 12, gd.Player.ID)
+		assert(t, "TERRORIST", gd.Player.Side)
+		assert(t, "smokegrenade", gd.Grenade)
+		assert(t, Position{-300, -1200, -160}, gd.Position)
+		assert(t, 163, gd.Entindex)
+	})
+
+	t.Run("InfernoExpired", func(t *testing.T) {
+
+		// given
+		lines := map[string]bool{
+			line(`inferno entindex 171 expired at [-300 -1200 -160]`):      false,
+			line(`inferno entindex 171 extinguished at [-300 -1200 -160]`): true,
+		}
+
+		for l, extinguished := range lines {
+
+			// when
+			m, err := Parse(l)
+
+			// then
+			assert(t, nil, err)
+			assert(t, "InfernoExpired", m.GetType())
+
+			// when
+			ie, ok := m.(InfernoExpired)
+
+			// then
+			assert(t, true, ok)
+			assert(t, 171, ie.Entindex)
+			assert(t, Position{-300, -1200, -160}, ie.Position)
+			assert(t, extinguished, ie.Extinguished)
+		}
+	})
+
+	t.Run("SmokeExpired", func(t *testing.T) {
+
+		// given
+		l := line(`smokegrenade entindex 163 expired at [-300 -1200 -160]`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "SmokeExpired", m.GetType())
+
+		// when
+		se, ok := m.(SmokeExpired)
+
+		// then
+		assert(t, true, ok)
+		assert(t, 163, se.Entindex)
+		assert(t, Position{-300, -1200, -160}, se.Position)
+	})
+
 	t.Run("PlayerBlinded", func(t *testing.T) {
 
 		// given
@@ -927,6 +1735,63 @@ func TestMessages(t *testing.T) {
 		assert(t, 21, g.Duration)
 	})
 
+	t.Run("GameOver Modes", func(t *testing.T) {
+
+		// given
+		lines := map[string]string{
+			"deathmatch":         line(`Game Over: deathmatch mg_deathmatch de_dust2 score 0:0 after 10 min`),
+			"gungameprogressive": line(`Game Over: gungameprogressive mg_armsrace ar_shoots score 0:0 after 5 min`),
+			"scrimcomp2v2":       line(`Game Over: scrimcomp2v2  workshop/123/de_shortnuke score 9:3 after 20 min`),
+		}
+
+		for mode, l := range lines {
+
+			// when
+			m, err := Parse(l)
+
+			// then
+			assert(t, nil, err)
+			assert(t, "GameOver", m.GetType())
+			assert(t, mode, m.(GameOver).Mode)
+		}
+	})
+
+	t.Run("PlayerWeaponUpgrade", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<2><STEAM_1:1:0101011><TERRORIST>" triggered "Weapon_Upgrade" (level "2")`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "PlayerWeaponUpgrade", m.GetType())
+
+		// when
+		pw, ok := m.(PlayerWeaponUpgrade)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "Player-Name", pw.Player.Name)
+		assert(t, 1, len(pw.Props))
+		assert(t, Property{"level", "2"}, pw.Props[0])
+	})
+
+	t.Run("Unassigned Side", func(t *testing.T) {
+
+		// given
+		l := line(`"Player-Name<12><STEAM_1:1:0101011><Unassigned>" [-225 -1829 -168] killed "Zim<20><BOT><Unassigned>" [-476 -1709 -110] with "knifegg"`)
+
+		// when
+		m, err := Parse(l)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "PlayerKill", m.GetType())
+		assert(t, "Unassigned", m.(PlayerKill).Attacker.Side)
+	})
+
 	t.Run("PlayerBanned", func(t *testing.T) {
 
 		// given
@@ -1051,6 +1916,72 @@ func TestParse(t *testing.T) {
 	})
 }
 
+func TestProperties(t *testing.T) {
+
+	t.Run("parse in order", func(t *testing.T) {
+
+		// when
+		p := ParseProperties(` (damage "32") (hitgroup "left leg") foo (reason "Kicked (by) "Console"")`)
+
+		// then
+		assert(t, 3, len(p))
+		assert(t, Property{"damage", "32"}, p[0])
+		assert(t, Property{"hitgroup", "left leg"}, p[1])
+		assert(t, Property{"reason", `Kicked (by) "Console"`}, p[2])
+	})
+
+	t.Run("parse empty", func(t *testing.T) {
+
+		// when
+		p := ParseProperties(``)
+
+		// then
+		assert(t, 0, len(p))
+	})
+
+	t.Run("get", func(t *testing.T) {
+
+		// given
+		p := ParseProperties(`(CT "3") (T "5")`)
+
+		// when
+		ct, okCT := p.Get("CT")
+		foo, okFoo := p.Get("foo")
+
+		// then
+		assert(t, "3", ct)
+		assert(t, true, okCT)
+		assert(t, "", foo)
+		assert(t, false, okFoo)
+	})
+
+	t.Run("map", func(t *testing.T) {
+
+		// when
+		m := ParseProperties(`(CT "3") (T "5")`).Map()
+
+		// then
+		assert(t, 2, len(m))
+		assert(t, "3", m["CT"])
+		assert(t, "5", m["T"])
+	})
+
+	t.Run("without", func(t *testing.T) {
+
+		// given
+		p := ParseProperties(`(CT "3") (T "5") (foo "bar")`)
+
+		// when
+		rest := p.Without("CT", "T")
+		none := p.Without("CT", "T", "foo")
+
+		// then
+		assert(t, 1, len(rest))
+		assert(t, Property{"foo", "bar"}, rest[0])
+		assert(t, true, none == nil)
+	})
+}
+
 func TestHelpers(t *testing.T) {
 
 	t.Run("toInt", func(t *testing.T) {
@@ -1064,6 +1995,42 @@ func TestHelpers(t *testing.T) {
 		assert(t, 0, i2)
 	})
 
+	t.Run("toInventory", func(t *testing.T) {
+
+		// when
+		inv := toInventory([]string{"weapon_m4a1", "kevlar(50)", "helmet", "defuser"})
+
+		// then
+		assert(t, 1, len(inv.Weapons))
+		assert(t, "m4a1", inv.Weapons[0])
+		assert(t, 50, inv.Armor)
+		assert(t, true, inv.Helmet)
+		assert(t, true, inv.Defuser)
+		assert(t, false, inv.C4)
+	})
+
+	t.Run("toFlags", func(t *testing.T) {
+
+		// when
+		f1 := toFlags(` (headshot penetrated) (damage "1") (revenge)`)
+		f2 := toFlags(``)
+
+		// then
+		assert(t, 3, len(f1))
+		assert(t, "headshot", f1[0])
+		assert(t, "penetrated", f1[1])
+		assert(t, "revenge", f1[2])
+		assert(t, 0, len(f2))
+	})
+
+	t.Run("contains", func(t *testing.T) {
+
+		// then
+		assert(t, true, contains([]string{"foo", "bar"}, "bar"))
+		assert(t, false, contains([]string{"foo", "bar"}, "baz"))
+		assert(t, false, contains(nil, "baz"))
+	})
+
 	t.Run("toFloat", func(t *testing.T) {
 
 		// when
@@ -50,7 +50,7 @@ func main() {
 
 		if errParse != nil {
 			// print parse errors to stderr
-			fmt.Fprintf(os.Stderr, "ERROR: %s", csgolog.ToJSON(m))
+			fmt.Fprintf(os.Stderr, "ERROR: %s: %s\n", errParse, l)
 		} else {
 			// print to stdout
 			fmt.Fprintf(os.Stdout, "%s", csgolog.ToJSON(m))
@@ -0,0 +1,224 @@
+package csgolog
+
+import (
+	"time"
+)
+
+type (
+
+	// Blind holds a player blinded by a flashbang, Self
+	// is set if the thrower blinded themselves
+	Blind struct {
+		Player   Player  `json:"player"`
+		For      float32 `json:"for"`
+		Teammate bool    `json:"teammate"`
+		Self     bool    `json:"self"`
+	}
+
+	// Hit holds the damage a grenade caused to a player, Self
+	// is set if the thrower damaged themselves
+	Hit struct {
+		Player   Player `json:"player"`
+		Damage   int    `json:"damage"`
+		Teammate bool   `json:"teammate"`
+		Self     bool   `json:"self"`
+	}
+
+	// Grenade holds a thrown grenade and the effects it caused
+	Grenade struct {
+		Time             time.Time `json:"time"`
+		Thrower          Player    `json:"thrower"`
+		Grenade          string    `json:"grenade"`
+		Position         Position  `json:"pos"`
+		Entindex         int       `json:"entindex"`
+		Detonated        bool      `json:"detonated"`
+		DetonatePosition Position  `json:"detonate_pos"`
+		Expired          bool      `json:"expired"`
+		Blinded          []Blind   `json:"blinded"`
+		Hits             []Hit     `json:"hits"`
+		EnemiesBlinded   int       `json:"enemies_blinded"`
+		TeammatesBlinded int       `json:"teammates_blinded"`
+		SelfBlinded      bool      `json:"self_blinded"`
+		BlindDuration    float32   `json:"blind_duration"`
+		EnemyDamage      int       `json:"enemy_damage"`
+		TeamDamage       int       `json:"team_damage"`
+		SelfDamage       int       `json:"self_damage"`
+	}
+
+	// GrenadeTracker links the effects of grenades to their throws
+	GrenadeTracker struct {
+		Grenades []*Grenade `json:"grenades"`
+
+		entindex map[int]*Grenade
+		thrown   map[int][]*Grenade
+	}
+)
+
+// NewGrenadeTracker returns a GrenadeTracker without any grenades
+func NewGrenadeTracker() *GrenadeTracker {
+	return &GrenadeTracker{
+		Grenades: []*Grenade{},
+		entindex: map[int]*Grenade{},
+		thrown:   map[int][]*Grenade{},
+	}
+}
+
+// CorrelateGrenades returns all thrown grenades of the messages
+// with the effects they caused
+func CorrelateGrenades(msgs []Message) []*Grenade {
+
+	g := NewGrenadeTracker()
+
+	for _, m := range msgs {
+		g.Update(m)
+	}
+
+	return g.Grenades
+}
+
+// Update links the message to a grenade or adds a new grenade. Blinds and
+// detonations are linked by the entindex of the throw, damage by hegrenade
+// or inferno to the latest burning grenade of that kind of the attacker
+func (g *GrenadeTracker) Update(msg Message) {
+
+	switch msg := msg.(type) {
+
+	case WorldMatchStart:
+		*g = *NewGrenadeTracker()
+
+	case PlayerThrew:
+		gr := &Grenade{
+			Time:     msg.Time,
+			Thrower:  msg.Player,
+			Grenade:  msg.Grenade,
+			Position: msg.Position,
+			Entindex: msg.Entindex,
+			Blinded:  []Blind{},
+			Hits:     []Hit{},
+		}
+
+		g.Grenades = append(g.Grenades, gr)
+		g.thrown[msg.Player.ID] = append(g.thrown[msg.Player.ID], gr)
+
+		if msg.Entindex > 0 {
+			g.entindex[msg.Entindex] = gr
+		}
+
+	case PlayerBlinded:
+		if gr, ok := g.entindex[msg.Entindex]; ok {
+			gr.blind(msg)
+		}
+
+	case GrenadeDetonated:
+		if gr, ok := g.entindex[msg.Entindex]; ok {
+			gr.Detonated = true
+			gr.DetonatePosition = msg.Position
+		}
+
+	case SmokeExpired:
+		if gr, ok := g.entindex[msg.Entindex]; ok {
+			gr.Expired = true
+		}
+
+	case InfernoExpired:
+		if gr, ok := g.entindex[msg.Entindex]; ok {
+			gr.Expired = true
+		}
+
+	case PlayerAttack:
+		if gr := g.latest(msg.Attacker.ID, msg.Weapon); gr != nil {
+			gr.hit(msg)
+		}
+	}
+}
+
+// latest returns the latest grenade of the player which could cause
+// damage with weapon, preferring grenades that did not expire yet
+func (g *GrenadeTracker) latest(player int, weapon string) *Grenade {
+
+	var found *Grenade
+	thrown := g.thrown[player]
+
+	for i := len(thrown) - 1; i >= 0; i-- {
+
+		if !causes(thrown[i].Grenade, weapon) {
+			continue
+		}
+
+		if !thrown[i].Expired {
+			return thrown[i]
+		}
+
+		if found == nil {
+			found = thrown[i]
+		}
+	}
+
+	return found
+}
+
+// blind adds a blinded player to the grenade
+func (gr *Grenade) blind(msg PlayerBlinded) {
+
+	self := samePlayer(msg.Victim, gr.Thrower)
+	teammate := !self && msg.Victim.Side == gr.Thrower.Side
+
+	gr.Blinded = append(gr.Blinded, Blind{
+		Player:   msg.Victim,
+		For:      msg.For,
+		Teammate: teammate,
+		Self:     self,
+	})
+
+	gr.BlindDuration += msg.For
+
+	switch {
+	case self:
+		gr.SelfBlinded = true
+	case teammate:
+		gr.TeammatesBlinded++
+	default:
+		gr.EnemiesBlinded++
+	}
+}
+
+// hit adds the damage of an attack to the grenade
+func (gr *Grenade) hit(msg PlayerAttack) {
+
+	self := samePlayer(msg.Victim, msg.Attacker)
+	teammate := !self && msg.Victim.Side == msg.Attacker.Side
+
+	gr.Hits = append(gr.Hits, Hit{
+		Player:   msg.Victim,
+		Damage:   msg.Damage,
+		Teammate: teammate,
+		Self:     self,
+	})
+
+	switch {
+	case self:
+		gr.SelfDamage += msg.Damage
+	case teammate:
+		gr.TeamDamage += msg.Damage
+	default:
+		gr.EnemyDamage += msg.Damage
+	}
+}
+
+// causes reports whether a thrown grenade causes damage with weapon
+func causes(grenade, weapon string) bool {
+
+	switch weapon {
+	case "hegrenade":
+		return grenade == "hegrenade"
+	case "inferno":
+		return grenade == "molotov" || grenade == "incgrenade"
+	}
+
+	return false
+}
+
+// samePlayer reports whether a and b are the same player
+func samePlayer(a, b Player) bool {
+	return a.ID == b.ID && a.SteamID == b.SteamID
+}
@@ -0,0 +1,115 @@
+package csgolog
+
+import (
+	"testing"
+)
+
+func TestGrenadeTracker(t *testing.T) {
+
+	t.Run("flashbang blinds", func(t *testing.T) {
+
+		// when
+		g := CorrelateGrenades(parseLines(
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" threw flashbang [-716 -1636 -170] flashbang entindex 163)`,
+			`"Bravo<3><STEAM_1:0:1002><CT>" blinded for 3.50 by "Alpha<2><STEAM_1:0:1001><TERRORIST>" from flashbang entindex 163`,
+			`"Charlie<4><STEAM_1:0:1003><CT>" blinded for 1.50 by "Alpha<2><STEAM_1:0:1001><TERRORIST>" from flashbang entindex 163`,
+			`"Delta<5><STEAM_1:0:1004><TERRORIST>" blinded for 1.00 by "Alpha<2><STEAM_1:0:1001><TERRORIST>" from flashbang entindex 163`,
+			`"Echo<6><STEAM_1:0:1005><CT>" blinded for 2.00 by "Foxtrot<7><STEAM_1:0:1006><TERRORIST>" from flashbang entindex 99`,
+		))
+
+		// then
+		assert(t, 1, len(g))
+		assert(t, "Alpha", g[0].Thrower.Name)
+		assert(t, "flashbang", g[0].Grenade)
+		assert(t, 163, g[0].Entindex)
+		assert(t, 3, len(g[0].Blinded))
+		assert(t, Blind{g[0].Blinded[0].Player, 3.5, false, false}, g[0].Blinded[0])
+		assert(t, "Delta", g[0].Blinded[2].Player.Name)
+		assert(t, true, g[0].Blinded[2].Teammate)
+		assert(t, 2, g[0].EnemiesBlinded)
+		assert(t, 1, g[0].TeammatesBlinded)
+		assert(t, float32(6), g[0].BlindDuration)
+	})
+
+	t.Run("self flash and self damage", func(t *testing.T) {
+
+		// when
+		g := CorrelateGrenades(parseLines(
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" threw flashbang [-716 -1636 -170] flashbang entindex 163)`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" blinded for 2.00 by "Alpha<2><STEAM_1:0:1001><TERRORIST>" from flashbang entindex 163`,
+			`"Delta<5><STEAM_1:0:1004><TERRORIST>" blinded for 1.00 by "Alpha<2><STEAM_1:0:1001><TERRORIST>" from flashbang entindex 163`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" threw hegrenade [-716 -1636 -170]`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" [-716 -1636 -170] attacked "Alpha<2><STEAM_1:0:1001><TERRORIST>" [0 0 0] with "hegrenade" (damage "20") (damage_armor "0") (health "80") (armor "0") (hitgroup "generic")`,
+		))
+
+		// then
+		assert(t, 2, len(g))
+		assert(t, true, g[0].SelfBlinded)
+		assert(t, true, g[0].Blinded[0].Self)
+		assert(t, false, g[0].Blinded[0].Teammate)
+		assert(t, 1, g[0].TeammatesBlinded)
+		assert(t, 0, g[0].EnemiesBlinded)
+		assert(t, 20, g[1].SelfDamage)
+		assert(t, 0, g[1].TeamDamage)
+	})
+
+	t.Run("grenade damage", func(t *testing.T) {
+
+		// when
+		g := CorrelateGrenades(parseLines(
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" threw hegrenade [-716 -1636 -170]`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" threw molotov [-716 -1636 -170]`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" [-716 -1636 -170] attacked "Bravo<3><STEAM_1:0:1002><CT>" [0 0 0] with "hegrenade" (damage "40") (damage_armor "5") (health "60") (armor "95") (hitgroup "generic")`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" [-716 -1636 -170] attacked "Delta<5><STEAM_1:0:1004><TERRORIST>" [0 0 0] with "hegrenade" (damage "10") (damage_armor "0") (health "90") (armor "0") (hitgroup "generic")`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" [-716 -1636 -170] attacked "Bravo<3><STEAM_1:0:1002><CT>" [0 0 0] with "inferno" (damage "8") (damage_armor "0") (health "52") (armor "95") (hitgroup "generic")`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" [-716 -1636 -170] attacked "Bravo<3><STEAM_1:0:1002><CT>" [0 0 0] with "ak47" (damage "30") (damage_armor "5") (health "22") (armor "90") (hitgroup "chest")`,
+		))
+
+		// then
+		assert(t, 2, len(g))
+
+		assert(t, "hegrenade", g[0].Grenade)
+		assert(t, 2, len(g[0].Hits))
+		assert(t, 40, g[0].EnemyDamage)
+		assert(t, 10, g[0].TeamDamage)
+		assert(t, true, g[0].Hits[1].Teammate)
+
+		assert(t, "molotov", g[1].Grenade)
+		assert(t, 1, len(g[1].Hits))
+		assert(t, 8, g[1].EnemyDamage)
+		assert(t, 0, g[1].TeamDamage)
+	})
+
+	t.Run("detonation and expiry", func(t *testing.T) {
+
+		// when
+		g := CorrelateGrenades(parseLines(
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" threw incgrenade [-716 -1636 -170] incgrenade entindex 171)`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" threw molotov [-716 -1636 -170] molotov entindex 172)`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" molotov detonated at [-300 -1200 -160] entindex 172`,
+			`inferno entindex 172 extinguished at [-300 -1200 -160]`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" [-716 -1636 -170] attacked "Bravo<3><STEAM_1:0:1002><CT>" [0 0 0] with "inferno" (damage "8") (damage_armor "0") (health "92") (armor "100") (hitgroup "generic")`,
+		))
+
+		// then
+		assert(t, 2, len(g))
+		assert(t, false, g[0].Expired)
+		assert(t, 8, g[0].EnemyDamage)
+		assert(t, true, g[1].Detonated)
+		assert(t, Position{-300, -1200, -160}, g[1].DetonatePosition)
+		assert(t, true, g[1].Expired)
+		assert(t, 0, g[1].EnemyDamage)
+	})
+
+	t.Run("match start resets", func(t *testing.T) {
+
+		// when
+		g := CorrelateGrenades(parseLines(
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" threw hegrenade [-716 -1636 -170]`,
+			`World triggered "Match_Start" on "de_cache"`,
+		))
+
+		// then
+		assert(t, 0, len(g))
+	})
+}
@@ -0,0 +1,261 @@
+package csgolog
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// slotPrefix prefixes the synthetic ids of players without a validated
+// SteamID, followed by the userid and the occupancy of the slot if the
+// slot was used by unvalidated players before, e.g. SLOT_2 and SLOT_2_1
+const slotPrefix = "SLOT_"
+
+// Identity is a player resolved across reconnects, name changes and side
+// switches, ID is the SteamID of the player or a synthetic id for bots and
+// players without a validated SteamID yet
+type Identity struct {
+	ID        string   `json:"id"`
+	Bot       bool     `json:"bot"`
+	Connected bool     `json:"connected"`
+	Names     []string `json:"names"`
+	Slots     []int    `json:"slots"`
+	Sides     []string `json:"sides"`
+}
+
+// Identities resolves players referenced by messages to stable identities,
+// it learns them from PlayerConnected, PlayerEntered, PlayerDisconnected,
+// PlayerSwitched and every other message referencing a player
+type Identities struct {
+	// All holds the identities in order of their first appearance
+	All []*Identity
+
+	ids   map[string]*Identity
+	slots map[int]*Identity
+	gens  map[int]int
+}
+
+// NewIdentities returns an empty Identities
+func NewIdentities() *Identities {
+	return &Identities{
+		ids:   map[string]*Identity{},
+		slots: map[int]*Identity{},
+		gens:  map[int]int{},
+	}
+}
+
+// Name returns the current name of the identity
+func (i *Identity) Name() string {
+
+	if len(i.Names) == 0 {
+		return ""
+	}
+
+	return i.Names[len(i.Names)-1]
+}
+
+// Side returns the current side of the identity
+func (i *Identity) Side() string {
+
+	if len(i.Sides) == 0 {
+		return ""
+	}
+
+	return i.Sides[len(i.Sides)-1]
+}
+
+// Update learns the identities of all players referenced by m
+func (r *Identities) Update(m Message) {
+
+	for _, p := range Players(m) {
+		r.observe(p)
+	}
+
+	switch m := m.(type) {
+
+	case PlayerConnected:
+		r.Resolve(m.Player).Connected = true
+
+	case PlayerEntered:
+		r.Resolve(m.Player).Connected = true
+
+	case PlayerDisconnected:
+		id := r.Resolve(m.Player)
+		id.Connected = false
+		delete(r.slots, m.Player.ID)
+
+		// the next unvalidated player of the slot is someone else
+		if strings.HasPrefix(id.ID, slotPrefix) {
+			r.gens[m.Player.ID]++
+		}
+
+	case PlayerSwitched:
+		id := r.Resolve(m.Player)
+		id.Sides = appendString(id.Sides, m.To)
+
+	case PlayerNameChanged:
+		id := r.Resolve(m.Player)
+		id.Names = appendString(id.Names, m.Name)
+	}
+}
+
+// Resolve returns the identity of a player, it creates a new one
+// if the player is not known yet
+func (r *Identities) Resolve(p Player) *Identity {
+
+	key := r.key(p)
+
+	if id, ok := r.ids[key]; ok {
+		return id
+	}
+
+	id := &Identity{ID: key, Bot: p.SteamID == "BOT"}
+	r.ids[key] = id
+	r.All = append(r.All, id)
+
+	return id
+}
+
+// Get returns the identity with the id, nil if it is not known
+func (r *Identities) Get(id string) *Identity {
+	return r.ids[id]
+}
+
+// Slot returns the identity currently using the userid, nil if the
+// slot is not in use
+func (r *Identities) Slot(userid int) *Identity {
+	return r.slots[userid]
+}
+
+// observe records the name, slot and side of a player on its identity
+func (r *Identities) observe(p Player) {
+
+	// the id of a player still pending validation is not
+	// known yet, it continues the identity of the slot
+	if validSteamID(p.SteamID) {
+		if id, ok := r.slots[p.ID]; ok && strings.HasPrefix(id.ID, slotPrefix) {
+			r.rename(id, p.SteamID)
+		}
+	}
+
+	id := r.Resolve(p)
+
+	if old, ok := r.slots[p.ID]; ok && old != id {
+		old.Connected = false
+	}
+
+	r.slots[p.ID] = id
+
+	id.Names = appendString(id.Names, p.Name)
+	id.Slots = appendInt(id.Slots, p.ID)
+
+	if p.Side != "" {
+		id.Sides = appendString(id.Sides, p.Side)
+	}
+}
+
+// rename moves an identity to a new id, if an identity with the new
+// id exists already the histories of both are merged
+func (r *Identities) rename(id *Identity, key string) {
+
+	delete(r.ids, id.ID)
+
+	existing, ok := r.ids[key]
+
+	if !ok {
+		id.ID = key
+		r.ids[key] = id
+		return
+	}
+
+	for _, n := range id.Names {
+		existing.Names = appendString(existing.Names, n)
+	}
+
+	for _, s := range id.Slots {
+		existing.Slots = appendInt(existing.Slots, s)
+	}
+
+	for _, s := range id.Sides {
+		existing.Sides = appendString(existing.Sides, s)
+	}
+
+	for slot, sid := range r.slots {
+		if sid == id {
+			r.slots[slot] = existing
+		}
+	}
+
+	for i, a := range r.All {
+		if a == id {
+			r.All = append(r.All[:i], r.All[i+1:]...)
+			break
+		}
+	}
+}
+
+// key returns the identity key of a player, the SteamID for players,
+// a synthetic id by name for bots and by slot for unvalidated players
+func (r *Identities) key(p Player) string {
+
+	if p.SteamID == "BOT" {
+		return "BOT_" + p.Name
+	}
+
+	if validSteamID(p.SteamID) {
+		return p.SteamID
+	}
+
+	key := slotPrefix + strconv.Itoa(p.ID)
+
+	if gen := r.gens[p.ID]; gen > 0 {
+		key += "_" + strconv.Itoa(gen)
+	}
+
+	return key
+}
+
+// validSteamID reports whether s is the SteamID of a player
+func validSteamID(s string) bool {
+	return strings.HasPrefix(s, "STEAM_") && s != "STEAM_ID_PENDING" && s != "STEAM_ID_LAN"
+}
+
+// Players returns all players referenced by a message in order of its fields
+func Players(m Message) []Player {
+
+	var players []Player
+
+	v := reflect.ValueOf(m)
+
+	if v.Kind() != reflect.Struct {
+		return players
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		if p, ok := v.Field(i).Interface().(Player); ok {
+			players = append(players, p)
+		}
+	}
+
+	return players
+}
+
+// appendString appends s to list if it differs from the last element
+func appendString(list []string, s string) []string {
+
+	if len(list) > 0 && list[len(list)-1] == s {
+		return list
+	}
+
+	return append(list, s)
+}
+
+// appendInt appends n to list if it differs from the last element
+func appendInt(list []int, n int) []int {
+
+	if len(list) > 0 && list[len(list)-1] == n {
+		return list
+	}
+
+	return append(list, n)
+}
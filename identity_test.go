@@ -0,0 +1,148 @@
+package csgolog
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIdentities(t *testing.T) {
+
+	t.Run("reconnect with new userid", func(t *testing.T) {
+
+		// given
+		r := NewIdentities()
+
+		// when
+		for _, m := range parseLines(
+			`"Player<2><STEAM_1:1:0101011><>" connected, address ""`,
+			`"Player<2><STEAM_1:1:0101011><>" entered the game`,
+			`"Player<2><STEAM_1:1:0101011>" switched from team <Unassigned> to <CT>`,
+			`"Player<2><STEAM_1:1:0101011><CT>" disconnected (reason "Disconnect")`,
+			`"Player<7><STEAM_1:1:0101011><>" connected, address ""`,
+		) {
+			r.Update(m)
+		}
+
+		// then
+		id := r.Get("STEAM_1:1:0101011")
+		assert(t, 1, len(r.All))
+		assert(t, "[2 7]", fmt.Sprint(id.Slots))
+		assert(t, "[CT]", fmt.Sprint(id.Sides))
+		assert(t, true, id.Connected)
+		assert(t, id, r.Slot(7))
+		assert(t, (*Identity)(nil), r.Slot(2))
+	})
+
+	t.Run("userid reuse", func(t *testing.T) {
+
+		// given
+		r := NewIdentities()
+
+		// when
+		for _, m := range parseLines(
+			`"Player<2><STEAM_1:1:0101011><CT>" disconnected (reason "Disconnect")`,
+			`"Other<2><STEAM_1:0:1234><>" connected, address ""`,
+		) {
+			r.Update(m)
+		}
+
+		// then
+		assert(t, 2, len(r.All))
+		assert(t, "Other", r.Slot(2).Name())
+		assert(t, false, r.Get("STEAM_1:1:0101011").Connected)
+	})
+
+	t.Run("userid reuse without validation", func(t *testing.T) {
+
+		// given
+		r := NewIdentities()
+
+		// when
+		for _, m := range parseLines(
+			`"Alice<2><STEAM_ID_LAN><>" connected, address ""`,
+			`"Alice<2><STEAM_ID_LAN><CT>" disconnected (reason "Disconnect")`,
+			`"Bob<2><STEAM_ID_LAN><>" connected, address ""`,
+		) {
+			r.Update(m)
+		}
+
+		// then
+		assert(t, 2, len(r.All))
+		assert(t, "[Alice]", fmt.Sprint(r.Get("SLOT_2").Names))
+		assert(t, false, r.Get("SLOT_2").Connected)
+		assert(t, "SLOT_2_1", r.Slot(2).ID)
+		assert(t, "[Bob]", fmt.Sprint(r.Slot(2).Names))
+		assert(t, true, r.Slot(2).Connected)
+	})
+
+	t.Run("name change", func(t *testing.T) {
+
+		// given
+		r := NewIdentities()
+
+		// when
+		for _, m := range parseLines(
+			`"Player<2><STEAM_1:1:0101011><CT>" changed name to "Renamed"`,
+			`"Renamed<2><STEAM_1:1:0101011><TERRORIST>" say "hi"`,
+		) {
+			r.Update(m)
+		}
+
+		// then
+		id := r.Get("STEAM_1:1:0101011")
+		assert(t, "[Player Renamed]", fmt.Sprint(id.Names))
+		assert(t, "[CT TERRORIST]", fmt.Sprint(id.Sides))
+		assert(t, "TERRORIST", id.Side())
+	})
+
+	t.Run("bots", func(t *testing.T) {
+
+		// given
+		r := NewIdentities()
+
+		// when
+		for _, m := range parseLines(
+			`"Dean<11><BOT><CT>" [-206 316 1613] killed "Scott<5><BOT><TERRORIST>" [939 214 1678] with "hkp2000"`,
+		) {
+			r.Update(m)
+		}
+
+		// then
+		assert(t, 2, len(r.All))
+		assert(t, true, r.Get("BOT_Dean").Bot)
+		assert(t, "BOT_Scott", r.Slot(5).ID)
+	})
+
+	t.Run("pending validation", func(t *testing.T) {
+
+		// given
+		r := NewIdentities()
+
+		// when
+		for _, m := range parseLines(
+			`"Player<2><STEAM_ID_PENDING><>" connected, address ""`,
+			`"Player<2><STEAM_1:1:0101011><>" STEAM USERID validated`,
+		) {
+			r.Update(m)
+		}
+
+		// then
+		assert(t, 1, len(r.All))
+		assert(t, "STEAM_1:1:0101011", r.All[0].ID)
+		assert(t, true, r.All[0].Connected)
+	})
+
+	t.Run("players of message", func(t *testing.T) {
+
+		// given
+		m := PlayerKillAssist{
+			Attacker: Player{Name: "a"},
+			Victim:   Player{Name: "b"},
+		}
+
+		// then
+		assert(t, 2, len(Players(m)))
+		assert(t, m.Victim, Players(m)[1])
+		assert(t, 0, len(Players(ServerMessage{})))
+	})
+}
@@ -0,0 +1,132 @@
+package maps
+
+import (
+	"math"
+	"sort"
+
+	"github.com/janstuemmel/csgo-log"
+)
+
+const (
+
+	// siteSize is the half edge length of a bombsite zone
+	siteSize = 300
+
+	// siteHeight is the height above and below the center of a bombsite
+	// a stacked bombsite zone reaches
+	siteHeight = 150
+)
+
+// rect returns the polygon of a rectangle
+func rect(x1, y1, x2, y2 float64) [][2]float64 {
+	return [][2]float64{{x1, y1}, {x2, y1}, {x2, y2}, {x1, y2}}
+}
+
+// bombsite returns the callout of the bombsite of the map containing the
+// position, the bombsites are built from csgolog.Bombsites on every call
+// so sites added to it later are resolved as well
+func bombsite(mapName string, pos csgolog.Position) string {
+
+	sites := csgolog.Bombsites[mapName]
+	names := []string{}
+
+	for name := range sites {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if z := siteZone(sites, name); z.Contains(pos) {
+			return z.Name
+		}
+	}
+
+	return ""
+}
+
+// siteZone returns the zone of a bombsite as square around its center,
+// limited in height if it overlaps another site like those of de_nuke
+func siteZone(sites map[string]csgolog.Position, name string) Zone {
+
+	c := sites[name]
+	x, y := float64(c.X), float64(c.Y)
+	z := Zone{Name: name + " Site", Polygon: rect(x-siteSize, y-siteSize, x+siteSize, y+siteSize)}
+
+	for other, o := range sites {
+
+		if other == name ||
+			math.Abs(float64(o.X-c.X)) >= 2*siteSize ||
+			math.Abs(float64(o.Y-c.Y)) >= 2*siteSize {
+			continue
+		}
+
+		minZ := float64(c.Z) - siteHeight
+		maxZ := float64(c.Z) + siteHeight
+		z.MinZ, z.MaxZ = &minZ, &maxZ
+		break
+	}
+
+	return z
+}
+
+// builtin returns the overviews and coarse zones of the active duty maps,
+// the zones are rough rectangles of the spawns and main areas which leave
+// out the bombsites of csgolog.Bombsites. Load measured zones with Load
+// for exact callouts
+func builtin() Maps {
+	return Maps{
+		"de_dust2": {Overview: &Overview{PosX: -2476, PosY: 3239, Scale: 4.4}, Zones: []Zone{
+			{Name: "CT Spawn", Polygon: rect(-100, 1900, 600, 2500)},
+			{Name: "Long A", Polygon: rect(1300, 400, 1900, 2200)},
+			{Name: "Upper Tunnels", Polygon: rect(-2200, 700, -1500, 1700)},
+			{Name: "Mid", Polygon: rect(-600, 0, -100, 1900)},
+			{Name: "T Spawn", Polygon: rect(-1200, -1300, 300, -300)},
+		}},
+		"de_mirage": {Overview: &Overview{PosX: -3230, PosY: 1713, Scale: 5}, Zones: []Zone{
+			{Name: "T Spawn", Polygon: rect(700, -900, 1700, 300)},
+			{Name: "CT Spawn", Polygon: rect(-2100, -2500, -1100, -1500)},
+			{Name: "Mid", Polygon: rect(-1200, -1300, 300, -300)},
+		}},
+		"de_inferno": {Overview: &Overview{PosX: -2087, PosY: 3870, Scale: 4.9}, Zones: []Zone{
+			{Name: "T Spawn", Polygon: rect(-2000, -600, -900, 1000)},
+			{Name: "CT Spawn", Polygon: rect(2000, 1400, 2900, 2600)},
+			{Name: "Mid", Polygon: rect(-300, -300, 1300, 900)},
+			{Name: "Banana", Polygon: rect(100, 1100, 800, 2500)},
+		}},
+		"de_nuke": {Overview: &Overview{PosX: -3453, PosY: 2887, Scale: 7}, Zones: []Zone{
+			{Name: "T Spawn", Polygon: rect(-3000, -1600, -1800, -200)},
+			{Name: "Outside", Polygon: rect(-1000, -3200, 1500, -1600)},
+			{Name: "CT Spawn", Polygon: rect(2000, -1400, 3200, 0)},
+		}},
+		"de_overpass": {Overview: &Overview{PosX: -4831, PosY: 1781, Scale: 5.2}, Zones: []Zone{
+			{Name: "T Spawn", Polygon: rect(-1700, -3400, -500, -2300)},
+			{Name: "CT Spawn", Polygon: rect(-3400, -300, -2600, 700)},
+			{Name: "Connector", Polygon: rect(-2200, -1200, -1500, 200)},
+		}},
+		"de_vertigo": {Overview: &Overview{PosX: -3168, PosY: 1762, Scale: 4}, Zones: []Zone{
+			{Name: "T Spawn", Polygon: rect(-1600, -2200, -600, -1300)},
+			{Name: "CT Spawn", Polygon: rect(-1500, 300, -700, 1300)},
+			{Name: "Mid", Polygon: rect(-1700, -1200, -700, 0)},
+		}},
+		"de_ancient": {Overview: &Overview{PosX: -2953, PosY: 2164, Scale: 5}, Zones: []Zone{
+			{Name: "T Spawn", Polygon: rect(-1000, -2800, 200, -1900)},
+			{Name: "CT Spawn", Polygon: rect(-800, 1300, 300, 2000)},
+			{Name: "Mid", Polygon: rect(-800, -700, 200, 300)},
+		}},
+		"de_anubis": {Overview: &Overview{PosX: -2796, PosY: 3328, Scale: 5.22}, Zones: []Zone{
+			{Name: "T Spawn", Polygon: rect(-800, -1900, 600, -1100)},
+			{Name: "CT Spawn", Polygon: rect(-700, 2300, 500, 3100)},
+			{Name: "Mid", Polygon: rect(-600, 0, 400, 1100)},
+		}},
+		"de_train": {Overview: &Overview{PosX: -2477, PosY: 2392, Scale: 4.7}, Zones: []Zone{
+			{Name: "T Spawn", Polygon: rect(1200, -1200, 2200, 200)},
+			{Name: "CT Spawn", Polygon: rect(-2200, 300, -1200, 1300)},
+		}},
+		"de_cache": {Overview: &Overview{PosX: -2000, PosY: 3250, Scale: 5.5}, Zones: []Zone{
+			{Name: "T Spawn", Polygon: rect(2600, -200, 3500, 1200)},
+			{Name: "CT Spawn", Polygon: rect(-1900, -100, -900, 900)},
+			{Name: "Mid", Polygon: rect(-100, 0, 1500, 1000)},
+		}},
+	}
+}
@@ -0,0 +1,195 @@
+package maps
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+
+	"github.com/janstuemmel/csgo-log"
+)
+
+// DefaultRadius is the radius in pixels of a point of a heatmap
+const DefaultRadius = 12
+
+// Heatmap collects positions of a map and renders them onto its radar
+type Heatmap struct {
+	Overview Overview
+	Radius   float64
+
+	points [][2]float64
+}
+
+// NewHeatmap returns an empty Heatmap for a map with the given overview
+func NewHeatmap(o Overview) *Heatmap {
+	return &Heatmap{Overview: o, Radius: DefaultRadius}
+}
+
+// Add adds a position to the heatmap
+func (h *Heatmap) Add(pos csgolog.Position) {
+	x, y := h.Overview.Position(pos)
+	h.points = append(h.points, [2]float64{x, y})
+}
+
+// AddFloat adds an exact position to the heatmap
+func (h *Heatmap) AddFloat(pos csgolog.PositionFloat) {
+	x, y := h.Overview.PositionFloat(pos)
+	h.points = append(h.points, [2]float64{x, y})
+}
+
+// AddKills adds the positions of the attackers of all kills
+func (h *Heatmap) AddKills(msgs []csgolog.Message) {
+
+	for _, m := range msgs {
+		if k, ok := m.(csgolog.PlayerKill); ok {
+			h.Add(k.AttackerPosition)
+		}
+	}
+}
+
+// AddDeaths adds the positions of the victims of all kills
+func (h *Heatmap) AddDeaths(msgs []csgolog.Message) {
+
+	for _, m := range msgs {
+		if k, ok := m.(csgolog.PlayerKill); ok {
+			h.Add(k.VictimPosition)
+		}
+	}
+}
+
+// AddGrenades adds the detonation positions of all grenades, the throw
+// position is added instead if the detonation is not logged, as not every
+// server logs detonations
+func (h *Heatmap) AddGrenades(msgs []csgolog.Message) {
+
+	positions := []csgolog.Position{}
+	thrown := map[int]int{}
+
+	for _, m := range msgs {
+		switch m := m.(type) {
+
+		case csgolog.PlayerThrew:
+			if m.Entindex > 0 {
+				thrown[m.Entindex] = len(positions)
+			}
+			positions = append(positions, m.Position)
+
+		case csgolog.GrenadeDetonated:
+			if i, ok := thrown[m.Entindex]; ok {
+				positions[i] = m.Position
+				delete(thrown, m.Entindex)
+			} else {
+				positions = append(positions, m.Position)
+			}
+		}
+	}
+
+	for _, pos := range positions {
+		h.Add(pos)
+	}
+}
+
+// Render draws the heatmap onto a copy of the radar image, radar images
+// of other sizes than RadarSize are scaled by their width
+func (h *Heatmap) Render(radar image.Image) *image.RGBA {
+
+	b := radar.Bounds()
+	out := image.NewRGBA(b)
+	draw.Draw(out, b, radar, b.Min, draw.Src)
+
+	heat := h.heat(b)
+	max := 0.0
+
+	for _, v := range heat {
+		max = math.Max(max, v)
+	}
+
+	if max == 0 {
+		return out
+	}
+
+	overlay := image.NewRGBA(b)
+
+	for i, v := range heat {
+
+		if v == 0 {
+			continue
+		}
+
+		x := b.Min.X + i%b.Dx()
+		y := b.Min.Y + i/b.Dx()
+		overlay.Set(x, y, heatColor(v/max))
+	}
+
+	draw.Draw(out, b, overlay, b.Min, draw.Over)
+
+	return out
+}
+
+// WritePNG renders the heatmap onto the radar image and writes it as PNG
+func (h *Heatmap) WritePNG(w io.Writer, radar image.Image) error {
+	return png.Encode(w, h.Render(radar))
+}
+
+// heat sums a gaussian kernel of each point for all pixels of the bounds
+func (h *Heatmap) heat(b image.Rectangle) []float64 {
+
+	heat := make([]float64, b.Dx()*b.Dy())
+	r := h.Radius
+	sigma := r / 2
+	scale := float64(b.Dx()) / RadarSize
+
+	for _, p := range h.points {
+
+		px := p[0]*scale + float64(b.Min.X)
+		py := p[1]*scale + float64(b.Min.Y)
+
+		for y := int(py - r); y <= int(py+r); y++ {
+			for x := int(px - r); x <= int(px+r); x++ {
+
+				if x < b.Min.X || y < b.Min.Y || x >= b.Max.X || y >= b.Max.Y {
+					continue
+				}
+
+				dx := float64(x) - px
+				dy := float64(y) - py
+				d := dx*dx + dy*dy
+
+				if d > r*r {
+					continue
+				}
+
+				heat[(y-b.Min.Y)*b.Dx()+(x-b.Min.X)] += math.Exp(-d / (2 * sigma * sigma))
+			}
+		}
+	}
+
+	return heat
+}
+
+// heatColor returns the color of a normalized heat value, from
+// transparent blue over green and yellow to opaque red
+func heatColor(v float64) color.NRGBA {
+
+	var r, g, b float64
+
+	switch {
+	case v < 0.25:
+		b, g = 1, v/0.25
+	case v < 0.5:
+		g, b = 1, 1-(v-0.25)/0.25
+	case v < 0.75:
+		g, r = 1, (v-0.5)/0.25
+	default:
+		r, g = 1, 1-(v-0.75)/0.25
+	}
+
+	return color.NRGBA{
+		R: uint8(r * 255),
+		G: uint8(g * 255),
+		B: uint8(b * 255),
+		A: uint8(math.Min(1, 0.2+v) * 200),
+	}
+}
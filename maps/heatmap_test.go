@@ -0,0 +1,133 @@
+package maps
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/janstuemmel/csgo-log"
+)
+
+func TestHeatmap(t *testing.T) {
+
+	dust2 := Overview{PosX: -2476, PosY: 3239, Scale: 4.4}
+
+	// the A site and T spawn of de_dust2, at (824, 168) and (403, 918)
+	// on a radar of RadarSize
+	site := csgolog.Position{X: 1150, Y: 2500, Z: 100}
+	spawn := csgolog.Position{X: -700, Y: -800, Z: 0}
+
+	radar := func(size int) *image.RGBA {
+
+		bg := image.NewRGBA(image.Rect(0, 0, size, size))
+
+		for i := range bg.Pix {
+			bg.Pix[i] = 0xff
+		}
+
+		return bg
+	}
+
+	t.Run("kills", func(t *testing.T) {
+
+		// given
+		bg := radar(RadarSize)
+		h := NewHeatmap(dust2)
+		kill := csgolog.PlayerKill{AttackerPosition: site, VictimPosition: spawn}
+
+		// when
+		h.AddKills([]csgolog.Message{kill})
+		img := h.Render(bg)
+
+		// then
+		assert(t, bg.Bounds(), img.Bounds())
+		assert(t, true, img.RGBAAt(824, 168) != bg.RGBAAt(824, 168))
+		assert(t, bg.RGBAAt(403, 918), img.RGBAAt(403, 918))
+	})
+
+	t.Run("deaths", func(t *testing.T) {
+
+		// given
+		bg := radar(RadarSize)
+		h := NewHeatmap(dust2)
+		kill := csgolog.PlayerKill{AttackerPosition: site, VictimPosition: spawn}
+
+		// when
+		h.AddDeaths([]csgolog.Message{kill})
+		img := h.Render(bg)
+
+		// then
+		assert(t, bg.RGBAAt(824, 168), img.RGBAAt(824, 168))
+		assert(t, true, img.RGBAAt(403, 918) != bg.RGBAAt(403, 918))
+	})
+
+	t.Run("smaller radar", func(t *testing.T) {
+
+		// given
+		bg := radar(RadarSize / 2)
+		h := NewHeatmap(dust2)
+		kill := csgolog.PlayerKill{AttackerPosition: site, VictimPosition: spawn}
+
+		// when
+		h.AddKills([]csgolog.Message{kill})
+		img := h.Render(bg)
+
+		// then
+		assert(t, bg.Bounds(), img.Bounds())
+		assert(t, true, img.RGBAAt(412, 84) != bg.RGBAAt(412, 84))
+		assert(t, bg.RGBAAt(201, 459), img.RGBAAt(201, 459))
+	})
+
+	t.Run("grenades", func(t *testing.T) {
+
+		// given
+		h := NewHeatmap(dust2)
+		detonation := csgolog.Position{X: 1200, Y: 2400, Z: 100}
+
+		// when
+		h.AddGrenades([]csgolog.Message{
+			csgolog.PlayerThrew{Position: spawn, Entindex: 101},
+			csgolog.PlayerThrew{Position: spawn, Entindex: 102},
+			csgolog.GrenadeDetonated{Position: detonation, Entindex: 101},
+		})
+
+		// then
+		x, y := dust2.Position(detonation)
+		sx, sy := dust2.Position(spawn)
+		assert(t, 2, len(h.points))
+		assert(t, [2]float64{x, y}, h.points[0])
+		assert(t, [2]float64{sx, sy}, h.points[1])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+
+		// given
+		bg := radar(100)
+		h := NewHeatmap(dust2)
+
+		// when
+		img := h.Render(bg)
+
+		// then
+		assert(t, true, bytes.Equal(bg.Pix, img.Pix))
+	})
+
+	t.Run("png", func(t *testing.T) {
+
+		// given
+		bg := radar(100)
+		h := NewHeatmap(dust2)
+		h.AddGrenades([]csgolog.Message{csgolog.PlayerThrew{Position: site}})
+		var buf bytes.Buffer
+
+		// when
+		err := h.WritePNG(&buf, bg)
+		img, derr := png.Decode(&buf)
+
+		// then
+		assert(t, nil, err)
+		assert(t, nil, derr)
+		assert(t, bg.Bounds(), img.Bounds())
+	})
+}
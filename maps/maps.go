@@ -0,0 +1,176 @@
+/*
+Package maps resolves positions of csgo log messages to map callouts.
+
+It holds the overviews and coarse zones of the active duty maps, load
+detailed or custom maps from a JSON file with Load or LoadFile:
+
+	{
+		"de_dust2": {
+			"zones": [
+				{"name": "A Site", "polygon": [[900, 2300], [1400, 2300], [1400, 2800], [900, 2800]]},
+				{"name": "Upper B", "polygon": [[-2200, 1700], [-1700, 1700], [-1700, 2300]], "min_z": 0, "max_z": 200}
+			]
+		}
+	}
+
+Zones are checked in order, the first zone containing a position wins.
+Positions outside of all zones of a map resolve to "A Site" or "B Site"
+if they are close to a bombsite center of csgolog.Bombsites.
+Add "overview": {"pos_x": -2476, "pos_y": 3239, "scale": 4.4} to a map
+to transform its positions to radar pixels, the values are found in the
+overview file of the map, e.g. resource/overviews/de_dust2.txt.
+*/
+package maps
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+
+	"github.com/janstuemmel/csgo-log"
+)
+
+type (
+
+	// Zone holds a named area of a map as polygon of X and Y world
+	// coordinates, MinZ and MaxZ limit the zone in height if they are set
+	Zone struct {
+		Name    string       `json:"name"`
+		Polygon [][2]float64 `json:"polygon"`
+		MinZ    *float64     `json:"min_z,omitempty"`
+		MaxZ    *float64     `json:"max_z,omitempty"`
+	}
+
+	// Map holds the zones of a map and its overview parameters
+	Map struct {
+		Zones    []Zone    `json:"zones"`
+		Overview *Overview `json:"overview,omitempty"`
+	}
+
+	// Maps holds the zone definitions per map name
+	Maps map[string]*Map
+
+	// KillCallouts holds a kill and the callouts of attacker and victim
+	KillCallouts struct {
+		csgolog.PlayerKill
+		AttackerCallout string `json:"attacker_callout"`
+		VictimCallout   string `json:"victim_callout"`
+	}
+)
+
+// Default holds the built-in maps, Load and LoadFile add maps to it
+var Default = builtin()
+
+// Load adds the maps of a JSON document to the default maps
+func Load(r io.Reader) error {
+	return Default.Load(r)
+}
+
+// LoadFile adds the maps of a JSON file to the default maps
+func LoadFile(name string) error {
+	return Default.LoadFile(name)
+}
+
+// Callout returns the callout of the position on the map by
+// the default maps, empty if no zone contains the position
+func Callout(mapName string, pos csgolog.Position) string {
+	return Default.Callout(mapName, pos)
+}
+
+// EnrichKill returns the kill with the callouts of attacker
+// and victim by the default maps
+func EnrichKill(mapName string, k csgolog.PlayerKill) KillCallouts {
+	return Default.EnrichKill(mapName, k)
+}
+
+// Load adds the maps of a JSON document, a map already
+// known is replaced
+func (m Maps) Load(r io.Reader) error {
+
+	loaded := Maps{}
+
+	if err := json.NewDecoder(r).Decode(&loaded); err != nil {
+		return err
+	}
+
+	for name, mp := range loaded {
+		m[name] = mp
+	}
+
+	return nil
+}
+
+// LoadFile adds the maps of a JSON file
+func (m Maps) LoadFile(name string) error {
+
+	f, err := os.Open(name)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	return m.Load(f)
+}
+
+// Callout returns the name of the first zone of the map containing the
+// position, or else of the bombsite of csgolog.Bombsites containing it,
+// empty if there is none. Workshop maps are looked up by their name
+// without path
+func (m Maps) Callout(mapName string, pos csgolog.Position) string {
+
+	name := path.Base(mapName)
+
+	if mp, ok := m[name]; ok {
+		for _, z := range mp.Zones {
+			if z.Contains(pos) {
+				return z.Name
+			}
+		}
+	}
+
+	return bombsite(name, pos)
+}
+
+// EnrichKill returns the kill with the callouts of attacker and victim
+func (m Maps) EnrichKill(mapName string, k csgolog.PlayerKill) KillCallouts {
+	return KillCallouts{
+		PlayerKill:      k,
+		AttackerCallout: m.Callout(mapName, k.AttackerPosition),
+		VictimCallout:   m.Callout(mapName, k.VictimPosition),
+	}
+}
+
+// Contains reports whether the position is inside the zone
+func (z Zone) Contains(pos csgolog.Position) bool {
+
+	if z.MinZ != nil && float64(pos.Z) < *z.MinZ {
+		return false
+	}
+
+	if z.MaxZ != nil && float64(pos.Z) > *z.MaxZ {
+		return false
+	}
+
+	return inPolygon(z.Polygon, float64(pos.X), float64(pos.Y))
+}
+
+// inPolygon checks by ray casting whether x and y are inside the polygon
+func inPolygon(poly [][2]float64, x, y float64) bool {
+
+	in := false
+
+	for i, j := 0, len(poly)-1; i < len(poly); j, i = i, i+1 {
+
+		xi, yi := poly[i][0], poly[i][1]
+		xj, yj := poly[j][0], poly[j][1]
+
+		if (yi > y) != (yj > y) && x < (xj-xi)*(y-yi)/(yj-yi)+xi {
+			in = !in
+		}
+	}
+
+	return in
+}
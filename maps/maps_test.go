@@ -0,0 +1,161 @@
+package maps
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/janstuemmel/csgo-log"
+)
+
+func TestCallout(t *testing.T) {
+
+	t.Run("builtin", func(t *testing.T) {
+
+		// then
+		assert(t, "A Site", Callout("de_dust2", csgolog.Position{X: 1100, Y: 2500, Z: 100}))
+		assert(t, "B Site", Callout("workshop/123/de_dust2", csgolog.Position{X: -1500, Y: 2600, Z: 30}))
+		assert(t, "", Callout("de_dust2", csgolog.Position{X: 5000, Y: 5000, Z: 0}))
+		assert(t, "", Callout("de_foo", csgolog.Position{X: 0, Y: 0, Z: 0}))
+	})
+
+	t.Run("height", func(t *testing.T) {
+
+		// then
+		assert(t, "A Site", Callout("de_nuke", csgolog.Position{X: 600, Y: -800, Z: -410}))
+		assert(t, "B Site", Callout("de_nuke", csgolog.Position{X: 600, Y: -800, Z: -770}))
+		assert(t, "", Callout("de_nuke", csgolog.Position{X: 600, Y: -800, Z: 0}))
+	})
+
+	t.Run("active duty maps", func(t *testing.T) {
+
+		// then
+		for mapName, mp := range builtin() {
+
+			assert(t, true, len(mp.Zones) > 0)
+			assert(t, 2, len(csgolog.Bombsites[mapName]))
+
+			for _, z := range mp.Zones {
+
+				// the center of each zone resolves to the zone
+				x, y := 0.0, 0.0
+				for _, p := range z.Polygon {
+					x += p[0] / float64(len(z.Polygon))
+					y += p[1] / float64(len(z.Polygon))
+				}
+
+				assert(t, z.Name, Callout(mapName, csgolog.Position{X: int(x), Y: int(y)}))
+			}
+		}
+	})
+
+	t.Run("bombsites added later", func(t *testing.T) {
+
+		// given
+		csgolog.Bombsites["de_foo"] = map[string]csgolog.Position{"A": {X: 0, Y: 0, Z: 0}}
+		defer delete(csgolog.Bombsites, "de_foo")
+
+		// then
+		assert(t, "A Site", Callout("de_foo", csgolog.Position{X: 100, Y: -100, Z: 0}))
+	})
+
+	t.Run("bombsites", func(t *testing.T) {
+
+		// then
+		for mapName, sites := range csgolog.Bombsites {
+			for name, center := range sites {
+				assert(t, name+" Site", Callout(mapName, center))
+			}
+		}
+	})
+}
+
+func TestLoad(t *testing.T) {
+
+	t.Run("custom map", func(t *testing.T) {
+
+		// given
+		m := Maps{}
+		js := `{"de_custom": {"zones": [
+			{"name": "Triangle", "polygon": [[0, 0], [100, 0], [0, 100]]},
+			{"name": "Square", "polygon": [[0, 0], [100, 0], [100, 100], [0, 100]]}
+		]}}`
+
+		// when
+		err := m.Load(strings.NewReader(js))
+
+		// then
+		assert(t, nil, err)
+		assert(t, "Triangle", m.Callout("de_custom", csgolog.Position{X: 10, Y: 10}))
+		assert(t, "Square", m.Callout("de_custom", csgolog.Position{X: 90, Y: 90}))
+		assert(t, "", m.Callout("de_custom", csgolog.Position{X: -10, Y: 90}))
+	})
+
+	t.Run("height limit at zero", func(t *testing.T) {
+
+		// given
+		m := Maps{}
+		js := `{"de_custom": {"zones": [
+			{"name": "Lower", "polygon": [[0, 0], [100, 0], [100, 100], [0, 100]], "max_z": 0},
+			{"name": "Upper", "polygon": [[0, 0], [100, 0], [100, 100], [0, 100]], "min_z": 0}
+		]}}`
+
+		// when
+		err := m.Load(strings.NewReader(js))
+
+		// then
+		assert(t, nil, err)
+		assert(t, "Lower", m.Callout("de_custom", csgolog.Position{X: 50, Y: 50, Z: -100}))
+		assert(t, "Upper", m.Callout("de_custom", csgolog.Position{X: 50, Y: 50, Z: 100}))
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+
+		// given
+		m := Maps{}
+
+		// when
+		err := m.Load(strings.NewReader(`{`))
+
+		// then
+		assert(t, true, err != nil)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+
+		// given
+		m := Maps{}
+
+		// when
+		err := m.LoadFile("testdata/missing.json")
+
+		// then
+		assert(t, true, err != nil)
+	})
+}
+
+func TestEnrichKill(t *testing.T) {
+
+	// given
+	msg, _ := csgolog.Parse(`L 11/05/2018 - 15:44:36: "Player-Name<12><STEAM_1:1:0101011><TERRORIST>" [1100 2500 100] killed "Zim<20><BOT><CT>" [-400 1000 0] with "ak47"`)
+
+	// when
+	k := EnrichKill("de_dust2", msg.(csgolog.PlayerKill))
+
+	// then
+	assert(t, "Player-Name", k.Attacker.Name)
+	assert(t, "A Site", k.AttackerCallout)
+	assert(t, "Mid", k.VictimCallout)
+	assert(t, true, strings.Contains(csgolog.ToJSON(k), `"attacker_callout":"A Site"`))
+}
+
+// helper
+
+func assert(t *testing.T, want interface{}, have interface{}) {
+
+	// mark as test helper function
+	t.Helper()
+
+	if want != have {
+		t.Error("Assertion failed for", t.Name(), "\n\twanted:\t", want, "\n\thave:\t", have)
+	}
+}
@@ -0,0 +1,53 @@
+package maps
+
+import (
+	"path"
+
+	"github.com/janstuemmel/csgo-log"
+)
+
+// RadarSize is the width and height in pixels of the radar images the
+// overview parameters of the maps refer to
+const RadarSize = 1024
+
+// Overview holds the parameters of the radar image of a map, PosX and PosY
+// are the world coordinates of the upper left corner of the radar and Scale
+// the world units per pixel of a radar image of RadarSize
+type Overview struct {
+	PosX  float64 `json:"pos_x"`
+	PosY  float64 `json:"pos_y"`
+	Scale float64 `json:"scale"`
+}
+
+// OverviewOf returns the overview of the map by the default maps
+func OverviewOf(mapName string) (Overview, bool) {
+	return Default.Overview(mapName)
+}
+
+// Overview returns the overview of the map, false if it is not known
+func (m Maps) Overview(mapName string) (Overview, bool) {
+
+	mp, ok := m[path.Base(mapName)]
+
+	if !ok || mp.Overview == nil {
+		return Overview{}, false
+	}
+
+	return *mp.Overview, true
+}
+
+// ToRadar converts world coordinates to pixel coordinates of the radar image
+func (o Overview) ToRadar(x, y float64) (float64, float64) {
+	return (x - o.PosX) / o.Scale, (o.PosY - y) / o.Scale
+}
+
+// Position converts a position to pixel coordinates of the radar image
+func (o Overview) Position(pos csgolog.Position) (float64, float64) {
+	return o.ToRadar(float64(pos.X), float64(pos.Y))
+}
+
+// PositionFloat converts an exact position to pixel coordinates
+// of the radar image
+func (o Overview) PositionFloat(pos csgolog.PositionFloat) (float64, float64) {
+	return o.ToRadar(float64(pos.X), float64(pos.Y))
+}
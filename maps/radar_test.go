@@ -0,0 +1,43 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/janstuemmel/csgo-log"
+)
+
+func TestOverview(t *testing.T) {
+
+	t.Run("builtin", func(t *testing.T) {
+
+		// when
+		o, ok := OverviewOf("workshop/123/de_dust2")
+
+		// then
+		assert(t, true, ok)
+		assert(t, Overview{PosX: -2476, PosY: 3239, Scale: 4.4}, o)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+
+		// when
+		_, ok := OverviewOf("de_foo")
+
+		// then
+		assert(t, false, ok)
+	})
+
+	t.Run("to radar", func(t *testing.T) {
+
+		// given
+		o := Overview{PosX: -1000, PosY: 1000, Scale: 2}
+
+		// when
+		x, y := o.Position(csgolog.Position{X: -1000, Y: 1000, Z: 0})
+		fx, fy := o.PositionFloat(csgolog.PositionFloat{X: 1000, Y: -1000, Z: 0})
+
+		// then
+		assert(t, [2]float64{0, 0}, [2]float64{x, y})
+		assert(t, [2]float64{1000, 1000}, [2]float64{fx, fy})
+	})
+}
@@ -0,0 +1,252 @@
+package csgolog
+
+const (
+	// WinReasonElimination is the reason of a round won by eliminating the enemies
+	WinReasonElimination = "elimination"
+	// WinReasonBombExploded is the reason of a round won by the bomb exploding
+	WinReasonBombExploded = "bomb_exploded"
+	// WinReasonBombDefused is the reason of a round won by defusing the bomb
+	WinReasonBombDefused = "bomb_defused"
+	// WinReasonTargetSaved is the reason of a round won by running out the time
+	WinReasonTargetSaved = "target_saved"
+	// WinReasonHostagesRescued is the reason of a round won by rescuing the hostages
+	WinReasonHostagesRescued = "hostages_rescued"
+	// WinReasonHostagesNotRescued is the reason of a round won by preventing
+	// the hostages from being rescued
+	WinReasonHostagesNotRescued = "hostages_not_rescued"
+	// WinReasonSurrender is the reason of a round won by the enemies surrendering
+	WinReasonSurrender = "surrender"
+	// WinReasonDraw is the reason of a round without winner
+	WinReasonDraw = "draw"
+)
+
+const (
+	// ModeCasual is the game mode of casual matches
+	ModeCasual = "casual"
+	// ModeCompetitive is the game mode of competitive matches
+	ModeCompetitive = "competitive"
+	// ModeWingman is the game mode of wingman matches
+	ModeWingman = "scrimcomp2v2"
+	// ModeRetakes is the game mode of retakes matches
+	ModeRetakes = "retakes"
+	// ModeArmsRace is the game mode of arms race matches
+	ModeArmsRace = "gungameprogressive"
+	// ModeDemolition is the game mode of demolition matches
+	ModeDemolition = "gungametrbomb"
+	// ModeDeathmatch is the game mode of deathmatch matches
+	ModeDeathmatch = "deathmatch"
+)
+
+// gameModes maps the cvars game_type and game_mode to a game mode
+var gameModes = map[[2]string]string{
+	{"0", "0"}: ModeCasual,
+	{"0", "1"}: ModeCompetitive,
+	{"0", "2"}: ModeWingman,
+	{"1", "0"}: ModeArmsRace,
+	{"1", "1"}: ModeDemolition,
+	{"1", "2"}: ModeDeathmatch,
+}
+
+// skirmishModes maps the cvar sv_skirmish_id of the war games, which run
+// on casual, to a game mode. Retakes plugins of community servers report
+// the mode they run on instead
+var skirmishModes = map[string]string{
+	"12": ModeRetakes,
+}
+
+// RoundBased reports whether a match of the game mode ends when a team
+// won the majority of mp_maxrounds. Deathmatch ends by the time limit and
+// arms race by a player reaching the last weapon level, both are only
+// over on GameOver
+func RoundBased(mode string) bool {
+	return mode != ModeDeathmatch && mode != ModeArmsRace
+}
+
+// winReasons maps the notices of TeamNotice to the reasons of a round win
+var winReasons = map[string]string{
+	"SFUI_Notice_CTs_Win":              WinReasonElimination,
+	"SFUI_Notice_Terrorists_Win":       WinReasonElimination,
+	"SFUI_Notice_Target_Bombed":        WinReasonBombExploded,
+	"SFUI_Notice_Bomb_Defused":         WinReasonBombDefused,
+	"SFUI_Notice_Target_Saved":         WinReasonTargetSaved,
+	"SFUI_Notice_All_Hostages_Rescued": WinReasonHostagesRescued,
+	"SFUI_Notice_Hostages_Not_Rescued": WinReasonHostagesNotRescued,
+	"SFUI_Notice_Terrorists_Surrender": WinReasonSurrender,
+	"SFUI_Notice_CTs_Surrender":        WinReasonSurrender,
+	"SFUI_Notice_Round_Draw":           WinReasonDraw,
+}
+
+// WinReason returns the reason of a round win for the notice
+// of a TeamNotice, empty if the notice is not known
+func WinReason(notice string) string {
+	return winReasons[notice]
+}
+
+type (
+
+	// RoundResult holds the outcome of a round
+	RoundResult struct {
+		Winner          string `json:"winner"`
+		Reason          string `json:"reason"`
+		ScoreCT         int    `json:"score_ct"`
+		ScoreT          int    `json:"score_t"`
+		HostagesRescued int    `json:"hostages_rescued"`
+		HostagesKilled  int    `json:"hostages_killed"`
+	}
+
+	// Match tracks the score and the round results of a match
+	Match struct {
+		Map               string        `json:"map"`
+		Mode              string        `json:"mode"`
+		MaxRounds         int           `json:"max_rounds"`
+		OvertimeMaxRounds int           `json:"overtime_max_rounds"`
+		ScoreCT           int           `json:"score_ct"`
+		ScoreT            int           `json:"score_t"`
+		Results           []RoundResult `json:"results"`
+		Over              bool          `json:"over"`
+		Winner            string        `json:"winner"`
+
+		gameType        string
+		gameMode        string
+		skirmishID      string
+		hostagesRescued int
+		hostagesKilled  int
+	}
+)
+
+// NewMatch returns a Match without any rounds
+func NewMatch() *Match {
+	return &Match{Results: []RoundResult{}}
+}
+
+// Update changes the state of the match by the given message,
+// a WorldMatchStart resets the match but keeps the server settings
+func (m *Match) Update(msg Message) {
+
+	switch msg := msg.(type) {
+
+	case ServerCvar:
+		m.setCvar(msg.Name, msg.Value)
+
+	case CvarDump:
+		m.setCvar(msg.Name, msg.Value)
+
+	case WorldMatchStart:
+		*m = Match{
+			Map:               msg.Map,
+			Mode:              m.Mode,
+			MaxRounds:         m.MaxRounds,
+			OvertimeMaxRounds: m.OvertimeMaxRounds,
+			Results:           []RoundResult{},
+			gameType:          m.gameType,
+			gameMode:          m.gameMode,
+			skirmishID:        m.skirmishID,
+		}
+
+	case MatchStatus:
+		m.Map = msg.Map
+		m.ScoreCT = msg.ScoreCT
+		m.ScoreT = msg.ScoreT
+
+	case PlayerHostageRescued:
+		m.hostagesRescued++
+
+	case PlayerHostageKilled:
+		m.hostagesKilled++
+
+	case TeamNotice:
+		m.ScoreCT = msg.ScoreCT
+		m.ScoreT = msg.ScoreT
+		m.Results = append(m.Results, RoundResult{
+			Winner:          msg.Side,
+			Reason:          WinReason(msg.Notice),
+			ScoreCT:         msg.ScoreCT,
+			ScoreT:          msg.ScoreT,
+			HostagesRescued: m.hostagesRescued,
+			HostagesKilled:  m.hostagesKilled,
+		})
+		m.hostagesRescued = 0
+		m.hostagesKilled = 0
+
+		if RoundBased(m.Mode) && m.decided() {
+			m.Over = true
+			m.Winner = winner(m.ScoreCT, m.ScoreT)
+		}
+
+	case GameOver:
+		// war games log the mode they run on
+		if _, ok := skirmishModes[m.skirmishID]; !ok {
+			m.Mode = msg.Mode
+		}
+		m.Map = msg.Map
+		m.ScoreCT = msg.ScoreCT
+		m.ScoreT = msg.ScoreT
+		m.Over = true
+		m.Winner = winner(msg.ScoreCT, msg.ScoreT)
+	}
+}
+
+// setCvar takes over the cvars relevant for the end of the match
+func (m *Match) setCvar(name, value string) {
+
+	switch name {
+	case "mp_maxrounds":
+		m.MaxRounds = toInt(value)
+	case "mp_overtime_maxrounds":
+		m.OvertimeMaxRounds = toInt(value)
+	case "game_type":
+		m.gameType = value
+	case "game_mode":
+		m.gameMode = value
+	case "sv_skirmish_id":
+		m.skirmishID = value
+	default:
+		return
+	}
+
+	if mode, ok := skirmishModes[m.skirmishID]; ok {
+		m.Mode = mode
+	} else if mode, ok := gameModes[[2]string{m.gameType, m.gameMode}]; ok {
+		m.Mode = mode
+	}
+}
+
+// decided reports whether the score decides the match. A team wins once it
+// has more than half of mp_maxrounds while the other team has less than
+// half, on a tie the match goes into overtime, which is only decided by the
+// score if mp_overtime_maxrounds is known and otherwise ends on GameOver
+func (m *Match) decided() bool {
+
+	half := m.MaxRounds / 2
+	ct, t := m.ScoreCT, m.ScoreT
+
+	for half > 0 {
+
+		if (ct > half && t < half) || (t > half && ct < half) {
+			return true
+		}
+
+		if ct < half || t < half {
+			return false
+		}
+
+		ct -= half
+		t -= half
+		half = m.OvertimeMaxRounds / 2
+	}
+
+	return false
+}
+
+// winner returns the side with the higher score, empty on a draw
+func winner(ct, t int) string {
+
+	switch {
+	case ct > t:
+		return "CT"
+	case t > ct:
+		return "TERRORIST"
+	}
+
+	return ""
+}
@@ -0,0 +1,283 @@
+package csgolog
+
+import (
+	"io"
+	"os"
+	"testing"
+)
+
+func TestWinReason(t *testing.T) {
+
+	t.Run("known notices", func(t *testing.T) {
+
+		// then
+		assert(t, WinReasonElimination, WinReason("SFUI_Notice_CTs_Win"))
+		assert(t, WinReasonBombExploded, WinReason("SFUI_Notice_Target_Bombed"))
+		assert(t, WinReasonHostagesRescued, WinReason("SFUI_Notice_All_Hostages_Rescued"))
+		assert(t, WinReasonHostagesNotRescued, WinReason("SFUI_Notice_Hostages_Not_Rescued"))
+	})
+
+	t.Run("unknown notice", func(t *testing.T) {
+
+		// then
+		assert(t, "", WinReason("foo"))
+	})
+}
+
+func TestMatch(t *testing.T) {
+
+	t.Run("track rounds and game over", func(t *testing.T) {
+
+		// given
+		m := NewMatch()
+
+		// when
+		for _, msg := range parseLines(
+			`World triggered "Match_Start" on "de_cache"`,
+			`Team "CT" triggered "SFUI_Notice_CTs_Win" (CT "1") (T "0")`,
+			`Team "TERRORIST" triggered "SFUI_Notice_Target_Bombed" (CT "1") (T "1")`,
+			`Team "CT" triggered "SFUI_Notice_Bomb_Defused" (CT "2") (T "1")`,
+			`Game Over: competitive mg_de_cache de_cache score 2:1 after 3 min`,
+		) {
+			m.Update(msg)
+		}
+
+		// then
+		assert(t, "de_cache", m.Map)
+		assert(t, 2, m.ScoreCT)
+		assert(t, 1, m.ScoreT)
+		assert(t, 3, len(m.Results))
+		assert(t, RoundResult{"CT", WinReasonElimination, 1, 0, 0, 0}, m.Results[0])
+		assert(t, RoundResult{"TERRORIST", WinReasonBombExploded, 1, 1, 0, 0}, m.Results[1])
+		assert(t, RoundResult{"CT", WinReasonBombDefused, 2, 1, 0, 0}, m.Results[2])
+		assert(t, true, m.Over)
+		assert(t, "CT", m.Winner)
+	})
+
+	t.Run("hostage win conditions", func(t *testing.T) {
+
+		// given
+		m := NewMatch()
+
+		// when
+		for _, msg := range parseLines(
+			`World triggered "Match_Start" on "cs_office"`,
+			`"Player-Name<2><STEAM_1:1:0101011><CT>" triggered "Touched_A_Hostage"`,
+			`"Player-Name<2><STEAM_1:1:0101011><CT>" triggered "Rescued_A_Hostage"`,
+			`"Player-Name<2><STEAM_1:1:0101011><CT>" triggered "Touched_A_Hostage"`,
+			`"Player-Name<2><STEAM_1:1:0101011><CT>" triggered "Rescued_A_Hostage"`,
+			`Team "CT" triggered "SFUI_Notice_All_Hostages_Rescued" (CT "1") (T "0")`,
+			`"Player-Name<3><STEAM_1:1:0101012><TERRORIST>" triggered "Killed_A_Hostage"`,
+			`Team "TERRORIST" triggered "SFUI_Notice_Hostages_Not_Rescued" (CT "1") (T "1")`,
+		) {
+			m.Update(msg)
+		}
+
+		// then
+		assert(t, "cs_office", m.Map)
+		assert(t, 2, len(m.Results))
+		assert(t, RoundResult{"CT", WinReasonHostagesRescued, 1, 0, 2, 0}, m.Results[0])
+		assert(t, RoundResult{"TERRORIST", WinReasonHostagesNotRescued, 1, 1, 0, 1}, m.Results[1])
+		assert(t, false, m.Over)
+	})
+
+	t.Run("match start resets", func(t *testing.T) {
+
+		// given
+		m := NewMatch()
+
+		// when
+		for _, msg := range parseLines(
+			`World triggered "Match_Start" on "de_cache"`,
+			`Team "CT" triggered "SFUI_Notice_CTs_Win" (CT "1") (T "0")`,
+			`World triggered "Match_Start" on "de_dust2"`,
+		) {
+			m.Update(msg)
+		}
+
+		// then
+		assert(t, "de_dust2", m.Map)
+		assert(t, 0, m.ScoreCT)
+		assert(t, 0, len(m.Results))
+	})
+
+	t.Run("draw", func(t *testing.T) {
+
+		// given
+		m := NewMatch()
+
+		// when
+		for _, msg := range parseLines(
+			`Game Over: competitive mg_de_cache de_cache score 15:15 after 50 min`,
+		) {
+			m.Update(msg)
+		}
+
+		// then
+		assert(t, true, m.Over)
+		assert(t, "", m.Winner)
+	})
+}
+
+func TestMatchModes(t *testing.T) {
+
+	tests := []struct {
+		file      string
+		mode      string
+		maxRounds int
+		over      string
+		winner    string
+	}{
+		{"testdata/wingman.log", ModeWingman, 16, "TeamNotice", "CT"},
+		{"testdata/deathmatch.log", ModeDeathmatch, 0, "GameOver", ""},
+		{"testdata/armsrace.log", ModeArmsRace, 0, "GameOver", ""},
+		{"testdata/retakes.log", ModeRetakes, 4, "TeamNotice", "TERRORIST"},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.file, func(t *testing.T) {
+
+			// given
+			m := NewMatch()
+			over := ""
+
+			// when
+			for _, msg := range readLog(t, tt.file) {
+
+				// then
+				assert(t, true, msg.GetType() != "Unknown")
+
+				m.Update(msg)
+
+				if m.Over && over == "" {
+					over = msg.GetType()
+				}
+			}
+
+			// then
+			assert(t, tt.mode, m.Mode)
+			assert(t, tt.maxRounds, m.MaxRounds)
+			assert(t, tt.over, over)
+			assert(t, tt.winner, m.Winner)
+		})
+	}
+
+	t.Run("round based without game over", func(t *testing.T) {
+
+		// given
+		m := NewMatch()
+
+		// when
+		for _, msg := range parseLines(
+			`"mp_maxrounds" = "30"`,
+			`World triggered "Match_Start" on "de_cache"`,
+			`Team "TERRORIST" triggered "SFUI_Notice_Target_Bombed" (CT "14") (T "15")`,
+		) {
+			m.Update(msg)
+		}
+
+		// then
+		assert(t, false, m.Over)
+
+		// when
+		m.Update(parseLines(`Team "TERRORIST" triggered "SFUI_Notice_Terrorists_Win" (CT "14") (T "16")`)[0])
+
+		// then
+		assert(t, 30, m.MaxRounds)
+		assert(t, true, m.Over)
+		assert(t, "TERRORIST", m.Winner)
+	})
+
+	t.Run("overtime", func(t *testing.T) {
+
+		// given
+		m := NewMatch()
+
+		// when
+		for _, msg := range parseLines(
+			`"mp_maxrounds" = "30"`,
+			`World triggered "Match_Start" on "de_cache"`,
+			`Team "TERRORIST" triggered "SFUI_Notice_Target_Bombed" (CT "15") (T "15")`,
+			`Team "CT" triggered "SFUI_Notice_CTs_Win" (CT "16") (T "15")`,
+		) {
+			m.Update(msg)
+		}
+
+		// then
+		assert(t, false, m.Over)
+		assert(t, "", m.Winner)
+
+		// when
+		m.Update(parseLines(`Game Over: competitive mg_active de_cache score 19:17 after 60 min`)[0])
+
+		// then
+		assert(t, true, m.Over)
+		assert(t, "CT", m.Winner)
+	})
+
+	t.Run("overtime with known overtime rounds", func(t *testing.T) {
+
+		// given
+		m := NewMatch()
+		scores := [][2]int{{16, 15}, {18, 18}, {19, 18}, {21, 19}, {22, 19}}
+		over := []bool{false, false, false, false, true}
+
+		m.Update(parseLines(`"mp_maxrounds" = "30"`)[0])
+		m.Update(parseLines(`"mp_overtime_maxrounds" = "6"`)[0])
+
+		for i, sc := range scores {
+
+			// when
+			m.Update(TeamNotice{Side: "CT", Notice: "SFUI_Notice_CTs_Win", ScoreCT: sc[0], ScoreT: sc[1]})
+
+			// then
+			assert(t, over[i], m.Over)
+		}
+
+		assert(t, "CT", m.Winner)
+	})
+}
+
+// readLog reads all messages of a logfile
+func readLog(t *testing.T, file string) []Message {
+
+	t.Helper()
+
+	f, err := os.Open(file)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer f.Close()
+
+	msgs := []Message{}
+	r := NewReader(f)
+
+	for {
+		m, err := r.Read()
+
+		if err == io.EOF {
+			return msgs
+		}
+
+		if err == nil {
+			msgs = append(msgs, m)
+		}
+	}
+}
+
+// parseLines parses log messages without the time prefix
+func parseLines(lines ...string) []Message {
+
+	msgs := []Message{}
+
+	for _, l := range lines {
+		if m, err := Parse(line(l)); err == nil {
+			msgs = append(msgs, m)
+		}
+	}
+
+	return msgs
+}
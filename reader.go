@@ -0,0 +1,242 @@
+package csgolog
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// RoundStatsBegin is the log message starting a round stats block
+	RoundStatsBegin = `JSON_BEGIN{`
+	// RoundStatsEnd is the log message ending a round stats block
+	RoundStatsEnd = `}}JSON_END`
+)
+
+// roundStatsFieldPattern captures a "key" : "value" line of a round stats block
+var roundStatsFieldPattern = regexp.MustCompile(`^"(\w+)"\s*:\s*"(.*)",?$`)
+
+// Reader reads messages line by line from a logfile and assembles
+// multi-line records like round stats to a single message
+type Reader struct {
+	scanner *bufio.Scanner
+}
+
+// NewReader returns a Reader reading from r
+func NewReader(r io.Reader) *Reader {
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	return &Reader{scanner: scanner}
+}
+
+// Read returns the next message, io.EOF when there are no more lines.
+// As with Parse, a line not being a log line returns ErrorNoMatch and
+// reading can be continued
+func (r *Reader) Read() (Message, error) {
+
+	if !r.scanner.Scan() {
+		if err := r.scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+
+	line := strings.TrimRight(r.scanner.Text(), "\r")
+
+	result := LogLinePattern.FindStringSubmatch(line)
+
+	if result == nil || result[2] != RoundStatsBegin {
+		return Parse(line)
+	}
+
+	ti, err := time.Parse("01/02/2006 - 15:04:05", result[1])
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.readRoundStats(ti)
+}
+
+// readRoundStats collects the lines of a round stats block until its end
+func (r *Reader) readRoundStats(ti time.Time) (Message, error) {
+
+	var lines []string
+
+	for r.scanner.Scan() {
+
+		line := strings.TrimRight(r.scanner.Text(), "\r")
+		result := LogLinePattern.FindStringSubmatch(line)
+
+		if result == nil {
+			continue
+		}
+
+		if result[2] == RoundStatsEnd {
+			return NewRoundStats(ti, lines), nil
+		}
+
+		lines = append(lines, result[2])
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.ErrUnexpectedEOF
+}
+
+// NewRoundStats creates RoundStats from the log messages
+// between the start and end of a round stats block
+func NewRoundStats(ti time.Time, lines []string) Message {
+
+	stats := RoundStats{
+		Meta:    NewMeta(ti, "RoundStats"),
+		Players: []RoundStatsPlayer{},
+	}
+
+	var fields []string
+	var rows []string
+
+	for _, l := range lines {
+
+		r := roundStatsFieldPattern.FindStringSubmatch(strings.TrimSpace(l))
+
+		if r == nil {
+			continue
+		}
+
+		switch r[1] {
+		case "name":
+			stats.Name = r[2]
+		case "round_number":
+			stats.RoundNumber = toInt(r[2])
+		case "score_ct":
+			stats.ScoreCT = toInt(r[2])
+		case "score_t":
+			stats.ScoreT = toInt(r[2])
+		case "map":
+			stats.Map = r[2]
+		case "server":
+			stats.Server = r[2]
+		case "fields":
+			fields = splitColumns(r[2])
+		default:
+			if strings.HasPrefix(r[1], "player_") {
+				rows = append(rows, r[2])
+			}
+		}
+	}
+
+	for _, row := range rows {
+		stats.Players = append(stats.Players, newRoundStatsPlayer(fields, splitColumns(row)))
+	}
+
+	return stats
+}
+
+// newRoundStatsPlayer assigns the columns of a player row by the field names
+func newRoundStatsPlayer(fields, columns []string) RoundStatsPlayer {
+
+	p := RoundStatsPlayer{}
+
+	for i, f := range fields {
+
+		if i >= len(columns) {
+			break
+		}
+
+		v := columns[i]
+
+		switch f {
+		case "accountid":
+			p.AccountID = toInt(v)
+		case "team":
+			p.Side = teamSide(v)
+		case "money":
+			p.Money = toInt(v)
+		case "kills":
+			p.Kills = toInt(v)
+		case "deaths":
+			p.Deaths = toInt(v)
+		case "assists":
+			p.Assists = toInt(v)
+		case "dmg":
+			p.Damage = toInt(v)
+		case "hsp":
+			p.HSP = toFloat32(v)
+		case "kdr":
+			p.KDR = toFloat32(v)
+		case "adr":
+			p.ADR = toInt(v)
+		case "mvp":
+			p.MVP = toInt(v)
+		case "ef":
+			p.EF = toInt(v)
+		case "ud":
+			p.UD = toInt(v)
+		case "3k":
+			p.Kills3 = toInt(v)
+		case "4k":
+			p.Kills4 = toInt(v)
+		case "5k":
+			p.Kills5 = toInt(v)
+		case "clutchk":
+			p.Clutch = toInt(v)
+		case "firstk":
+			p.First = toInt(v)
+		case "pistolk":
+			p.Pistol = toInt(v)
+		case "sniperk":
+			p.Sniper = toInt(v)
+		case "blindk":
+			p.Blind = toInt(v)
+		case "bombk":
+			p.Bomb = toInt(v)
+		case "firek":
+			p.Fire = toInt(v)
+		case "uniquek":
+			p.Unique = toInt(v)
+		case "dinks":
+			p.Dinks = toInt(v)
+		case "chickenk":
+			p.Chicken = toInt(v)
+		default:
+			if p.Extra == nil {
+				p.Extra = map[string]string{}
+			}
+			p.Extra[f] = v
+		}
+	}
+
+	return p
+}
+
+// splitColumns splits a comma separated row and trims the columns
+func splitColumns(v string) []string {
+
+	columns := strings.Split(v, ",")
+
+	for i, c := range columns {
+		columns[i] = strings.TrimSpace(c)
+	}
+
+	return columns
+}
+
+// teamSide converts the team number of round stats to a side
+func teamSide(v string) string {
+
+	switch v {
+	case "2":
+		return "TERRORIST"
+	case "3":
+		return "CT"
+	}
+
+	return ""
+}
@@ -0,0 +1,155 @@
+package csgolog
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+const roundStatsLog = `L 11/05/2018 - 15:44:36: JSON_BEGIN{
+L 11/05/2018 - 15:44:36: "name" : "round_stats",
+L 11/05/2018 - 15:44:36: "round_number" : "3",
+L 11/05/2018 - 15:44:36: "score_t" : "1",
+L 11/05/2018 - 15:44:36: "score_ct" : "2",
+L 11/05/2018 - 15:44:36: "map" : "de_cache",
+L 11/05/2018 - 15:44:36: "server" : "Server",
+L 11/05/2018 - 15:44:36: "fields" : "             accountid,   team,  money,  kills, deaths,assists,    dmg,    hsp,    kdr,    adr,    mvp,     ef,     ud,     3k,     4k,     5k,clutchk, firstk,pistolk,sniperk, blindk,  bombk,  firek,uniquek,  dinks,chickenk,  newk",
+L 11/05/2018 - 15:44:36: "players" : {
+L 11/05/2018 - 15:44:36: "player_0" : "      12345678,      3,   4350,      2,      0,      1,    244,  50.00,   2.00,     81,      1,      0,     12,      0,      0,      0,      0,      1,      0,      1,      0,      0,      0,      2,      1,      0,      7",
+L 11/05/2018 - 15:44:36: "player_1" : "             0,      2,   2700,      0,      1,      0,     14,   0.00,   0.00,      4,      0,      0,      0,      0,      0,      0,      0,      0,      0,      0,      0,      0,      0,      0,      0,      0,      0"
+L 11/05/2018 - 15:44:36: }}JSON_END
+`
+
+func TestReader(t *testing.T) {
+
+	t.Run("read messages", func(t *testing.T) {
+
+		// given
+		r := NewReader(strings.NewReader(line(`Starting Freeze period`) + "foo\r\n" + line(`Log file closed`)))
+
+		// when
+		m1, err1 := r.Read()
+		m2, err2 := r.Read()
+		m3, err3 := r.Read()
+		m4, err4 := r.Read()
+
+		// then
+		assert(t, nil, err1)
+		assert(t, "FreezTimeStart", m1.GetType())
+		assert(t, ErrorNoMatch, err2)
+		assert(t, nil, m2)
+		assert(t, nil, err3)
+		assert(t, "LogFileClosed", m3.GetType())
+		assert(t, io.EOF, err4)
+		assert(t, nil, m4)
+	})
+
+	t.Run("read round stats", func(t *testing.T) {
+
+		// given
+		r := NewReader(strings.NewReader(roundStatsLog + line(`World triggered "Round_End"`)))
+
+		// when
+		m, err := r.Read()
+
+		// then
+		assert(t, nil, err)
+		assert(t, "RoundStats", m.GetType())
+
+		// when
+		rs, ok := m.(RoundStats)
+
+		// then
+		assert(t, true, ok)
+		assert(t, "round_stats", rs.Name)
+		assert(t, 3, rs.RoundNumber)
+		assert(t, 2, rs.ScoreCT)
+		assert(t, 1, rs.ScoreT)
+		assert(t, "de_cache", rs.Map)
+		assert(t, "Server", rs.Server)
+		assert(t, 2, len(rs.Players))
+
+		p := rs.Players[0]
+		assert(t, 12345678, p.AccountID)
+		assert(t, "CT", p.Side)
+		assert(t, 4350, p.Money)
+		assert(t, 2, p.Kills)
+		assert(t, 0, p.Deaths)
+		assert(t, 1, p.Assists)
+		assert(t, 244, p.Damage)
+		assert(t, float32(50), p.HSP)
+		assert(t, float32(2), p.KDR)
+		assert(t, 81, p.ADR)
+		assert(t, 1, p.MVP)
+		assert(t, 12, p.UD)
+		assert(t, 1, p.First)
+		assert(t, 1, p.Sniper)
+		assert(t, 2, p.Unique)
+		assert(t, 1, p.Dinks)
+		assert(t, "7", p.Extra["newk"])
+
+		assert(t, "TERRORIST", rs.Players[1].Side)
+		assert(t, 14, rs.Players[1].Damage)
+
+		// when
+		m, err = r.Read()
+
+		// then
+		assert(t, nil, err)
+		assert(t, "WorldRoundEnd", m.GetType())
+	})
+
+	t.Run("unterminated round stats", func(t *testing.T) {
+
+		// given
+		r := NewReader(strings.NewReader(line(RoundStatsBegin) + line(`"name" : "round_stats",`)))
+
+		// when
+		m, err := r.Read()
+
+		// then
+		assert(t, io.ErrUnexpectedEOF, err)
+		assert(t, nil, m)
+	})
+
+	t.Run("round stats without spaces around colons", func(t *testing.T) {
+
+		// given
+		r := NewReader(strings.NewReader(
+			line(RoundStatsBegin) +
+				line(`"name": "round_stats",`) +
+				line(`"round_number":"3",`) +
+				line(`"map"  :  "de_cache",`) +
+				line(RoundStatsEnd)))
+
+		// when
+		m, err := r.Read()
+		stats := m.(RoundStats)
+
+		// then
+		assert(t, nil, err)
+		assert(t, "round_stats", stats.Name)
+		assert(t, 3, stats.RoundNumber)
+		assert(t, "de_cache", stats.Map)
+	})
+
+	t.Run("read example log", func(t *testing.T) {
+
+		// given
+		f, _ := os.Open("example/example.log")
+		defer f.Close()
+		r := NewReader(f)
+		n := 0
+
+		// when
+		_, err := r.Read()
+		for ; err == nil; _, err = r.Read() {
+			n++
+		}
+
+		// then
+		assert(t, io.EOF, err)
+		assert(t, 2894, n)
+	})
+}
@@ -0,0 +1,236 @@
+package csgolog
+
+// Team is a team of players, it keeps its identity when it swaps sides
+// at halftime or in overtime
+type Team struct {
+	Name    string      `json:"name"`
+	Side    string      `json:"side"`
+	Players []*Identity `json:"players"`
+}
+
+// TeamPlayer is a player referenced by a message annotated
+// with its identity and team
+type TeamPlayer struct {
+	Player
+	Identity *Identity `json:"identity"`
+	Team     *Team     `json:"team"`
+}
+
+// Roster tracks which players play in which team and which team plays on
+// which side, halftime and overtime swaps don't emit a PlayerSwitched per
+// player, so most players of a team seen on the side of the other team
+// swap the teams. Single players seen on the other side move to the other
+// team at the end of the round
+type Roster struct {
+	// Teams holds both teams, the first one starting as CT
+	Teams      [2]*Team
+	Identities *Identities
+	Swaps      int
+
+	members map[*Identity]*Team
+	sides   map[*Identity]string
+}
+
+// NewRoster returns an empty Roster
+func NewRoster() *Roster {
+	return &Roster{
+		Teams:      [2]*Team{{Side: "CT"}, {Side: "TERRORIST"}},
+		Identities: NewIdentities(),
+		members:    map[*Identity]*Team{},
+		sides:      map[*Identity]string{},
+	}
+}
+
+// Update learns the teams and sides of the players referenced by m
+func (r *Roster) Update(m Message) {
+
+	r.Identities.Update(m)
+
+	switch m := m.(type) {
+
+	case TeamPlaying:
+		// the team names are logged every round and are
+		// the most reliable source for a side swap
+		side := normalizeSide(m.Side)
+		if t := r.named(m.Team); t != nil && t.Side != side {
+			r.swap()
+		}
+		if t := r.Side(side); t != nil {
+			t.Name = m.Team
+		}
+		return
+
+	case PlayerSwitched:
+		r.join(r.Identities.Resolve(m.Player), m.To)
+		return
+
+	case PlayerJoinedTeam:
+		r.join(r.Identities.Resolve(m.Player), m.Team)
+		return
+
+	case WorldRoundEnd:
+		r.settle()
+		return
+	}
+
+	for _, p := range Players(m) {
+
+		side := normalizeSide(p.Side)
+
+		if r.Side(side) == nil {
+			continue
+		}
+
+		id := r.Identities.Resolve(p)
+		t, ok := r.members[id]
+
+		if !ok {
+			r.join(id, side)
+			continue
+		}
+
+		r.sides[id] = side
+
+		if t.Side != side && len(r.moved(t))*2 > len(t.Players) {
+			r.swap()
+		}
+	}
+}
+
+// Side returns the team currently playing on side, nil if side is
+// neither CT nor TERRORIST
+func (r *Roster) Side(side string) *Team {
+
+	side = normalizeSide(side)
+
+	for _, t := range r.Teams {
+		if t.Side == side {
+			return t
+		}
+	}
+
+	return nil
+}
+
+// Team returns the team of a player, the team currently playing on the
+// side of the player if the player is not known yet
+func (r *Roster) Team(p Player) *Team {
+
+	if t, ok := r.members[r.Identities.Resolve(p)]; ok {
+		return t
+	}
+
+	return r.Side(p.Side)
+}
+
+// Annotate returns the players referenced by m with their identity and team
+func (r *Roster) Annotate(m Message) []TeamPlayer {
+
+	var players []TeamPlayer
+
+	for _, p := range Players(m) {
+		players = append(players, TeamPlayer{
+			Player:   p,
+			Identity: r.Identities.Resolve(p),
+			Team:     r.Team(p),
+		})
+	}
+
+	return players
+}
+
+// join moves a player to the team playing on side, the player
+// leaves its team if side is Spectator or Unassigned
+func (r *Roster) join(id *Identity, side string) {
+
+	if t, ok := r.members[id]; ok {
+
+		if t.Side == normalizeSide(side) {
+			return
+		}
+
+		for i, p := range t.Players {
+			if p == id {
+				t.Players = append(t.Players[:i], t.Players[i+1:]...)
+				break
+			}
+		}
+
+		delete(r.members, id)
+	}
+
+	delete(r.sides, id)
+
+	if t := r.Side(side); t != nil {
+		t.Players = append(t.Players, id)
+		r.members[id] = t
+	}
+}
+
+// named returns the team with the name, nil if there is none
+func (r *Roster) named(name string) *Team {
+
+	for _, t := range r.Teams {
+		if name != "" && t.Name == name {
+			return t
+		}
+	}
+
+	return nil
+}
+
+// moved returns the players of the team seen on the side of
+// the other team in the current round
+func (r *Roster) moved(t *Team) []*Identity {
+
+	var moved []*Identity
+
+	for _, p := range t.Players {
+		if side, ok := r.sides[p]; ok && side != t.Side {
+			moved = append(moved, p)
+		}
+	}
+
+	return moved
+}
+
+// settle swaps the teams at the end of a round if more players of a team
+// were seen on the side of the other team than on its own, the remaining
+// players seen on the other side move to the other team
+func (r *Roster) settle() {
+
+	for _, t := range r.Teams {
+		if moved := len(r.moved(t)); moved*2 > len(r.seen(t)) {
+			r.swap()
+			break
+		}
+	}
+
+	for _, t := range r.Teams {
+		for _, p := range r.moved(t) {
+			r.join(p, r.sides[p])
+		}
+	}
+
+	r.sides = map[*Identity]string{}
+}
+
+// seen returns the players of the team seen in the current round
+func (r *Roster) seen(t *Team) []*Identity {
+
+	var seen []*Identity
+
+	for _, p := range t.Players {
+		if _, ok := r.sides[p]; ok {
+			seen = append(seen, p)
+		}
+	}
+
+	return seen
+}
+
+// swap swaps the sides of both teams
+func (r *Roster) swap() {
+	r.Teams[0].Side, r.Teams[1].Side = r.Teams[1].Side, r.Teams[0].Side
+	r.Swaps++
+}
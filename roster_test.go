@@ -0,0 +1,142 @@
+package csgolog
+
+import (
+	"testing"
+)
+
+func TestRoster(t *testing.T) {
+
+	t.Run("players join teams", func(t *testing.T) {
+
+		// given
+		r := NewRoster()
+
+		// when
+		for _, m := range parseLines(
+			`"Player<2><STEAM_1:1:0101011>" switched from team <Unassigned> to <CT>`,
+			`"Dean<11><BOT><TERRORIST>" say "hi"`,
+		) {
+			r.Update(m)
+		}
+
+		// then
+		assert(t, 1, len(r.Side("CT").Players))
+		assert(t, "STEAM_1:1:0101011", r.Side("CT").Players[0].ID)
+		assert(t, "BOT_Dean", r.Side("T").Players[0].ID)
+	})
+
+	t.Run("halftime swap", func(t *testing.T) {
+
+		// given
+		r := NewRoster()
+
+		// when
+		for _, m := range parseLines(
+			`"Player<2><STEAM_1:1:0101011><CT>" say "hi"`,
+			`"Dean<11><BOT><TERRORIST>" say "hi"`,
+			`"Player<2><STEAM_1:1:0101011><TERRORIST>" say "hi"`,
+			`"Dean<11><BOT><CT>" say "hi"`,
+		) {
+			r.Update(m)
+		}
+
+		// then
+		assert(t, 1, r.Swaps)
+		assert(t, "TERRORIST", r.Teams[0].Side)
+		assert(t, r.Teams[0], r.Team(Player{Name: "Player", ID: 2, SteamID: "STEAM_1:1:0101011"}))
+		assert(t, 1, len(r.Teams[0].Players))
+		assert(t, 1, len(r.Teams[1].Players))
+	})
+
+	t.Run("single player on other side", func(t *testing.T) {
+
+		// given
+		r := NewRoster()
+
+		// when
+		for _, m := range parseLines(
+			`"A<2><STEAM_1:0:1001><CT>" say "hi"`,
+			`"B<3><STEAM_1:0:2002><TERRORIST>" say "hi"`,
+			`"C<4><STEAM_1:0:3003><CT>" say "hi"`,
+			`"A<2><STEAM_1:0:1001><TERRORIST>" say "hi"`,
+			`"C<4><STEAM_1:0:3003><CT>" say "hi"`,
+			`World triggered "Round_End"`,
+		) {
+			r.Update(m)
+		}
+
+		// then
+		assert(t, 0, r.Swaps)
+		assert(t, "CT", r.Teams[0].Side)
+		assert(t, r.Teams[1], r.Team(Player{Name: "A", ID: 2, SteamID: "STEAM_1:0:1001"}))
+		assert(t, 1, len(r.Teams[0].Players))
+		assert(t, 2, len(r.Teams[1].Players))
+	})
+
+	t.Run("explicit switch", func(t *testing.T) {
+
+		// given
+		r := NewRoster()
+
+		// when
+		for _, m := range parseLines(
+			`"Player<2><STEAM_1:1:0101011><CT>" say "hi"`,
+			`"Dean<11><BOT><TERRORIST>" say "hi"`,
+			`"Player<2><STEAM_1:1:0101011>" switched from team <CT> to <TERRORIST>`,
+			`"Player<2><STEAM_1:1:0101011><TERRORIST>" say "hi"`,
+		) {
+			r.Update(m)
+		}
+
+		// then
+		assert(t, 0, r.Swaps)
+		assert(t, 0, len(r.Teams[0].Players))
+		assert(t, 2, len(r.Teams[1].Players))
+	})
+
+	t.Run("team names", func(t *testing.T) {
+
+		// given
+		r := NewRoster()
+
+		// when
+		for _, m := range parseLines(
+			`MatchStatus: Team playing "CT": Team Liquid`,
+			`MatchStatus: Team playing "TERRORIST": Natus Vincere`,
+			`MatchStatus: Team playing "CT": Natus Vincere`,
+			`MatchStatus: Team playing "TERRORIST": Team Liquid`,
+			`"Player<2><STEAM_1:1:0101011><CT>" say "hi"`,
+		) {
+			r.Update(m)
+		}
+
+		// then
+		assert(t, 1, r.Swaps)
+		assert(t, "Team Liquid", r.Side("T").Name)
+		assert(t, "Natus Vincere", r.Side("CT").Name)
+		assert(t, "Natus Vincere", r.Team(Player{ID: 2, SteamID: "STEAM_1:1:0101011"}).Name)
+	})
+
+	t.Run("annotate", func(t *testing.T) {
+
+		// given
+		r := NewRoster()
+		msgs := parseLines(
+			`MatchStatus: Team playing "CT": Team Liquid`,
+			`"Dean<11><BOT><CT>" [-206 316 1613] killed "Scott<5><BOT><TERRORIST>" [939 214 1678] with "hkp2000"`,
+		)
+
+		for _, m := range msgs {
+			r.Update(m)
+		}
+
+		// when
+		players := r.Annotate(msgs[1])
+
+		// then
+		assert(t, 2, len(players))
+		assert(t, "Team Liquid", players[0].Team.Name)
+		assert(t, "BOT_Dean", players[0].Identity.ID)
+		assert(t, "TERRORIST", players[1].Team.Side)
+	})
+}
@@ -0,0 +1,216 @@
+package csgolog
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// RoundWarmup is the kind of rounds played before the match starts
+	RoundWarmup = "warmup"
+	// RoundKnife is the kind of rounds with only knife kills and no purchases
+	RoundKnife = "knife"
+	// RoundLive is the kind of rounds counting for the match
+	RoundLive = "live"
+)
+
+type (
+
+	// Round holds the messages and the outcome of a round. Kind is set when
+	// the round completes, or when it starts for warmup rounds, only live
+	// rounds have a Number
+	Round struct {
+		Number   int       `json:"number"`
+		Kind     string    `json:"kind"`
+		Start    time.Time `json:"start"`
+		End      time.Time `json:"end"`
+		Winner   string    `json:"winner"`
+		Reason   string    `json:"reason"`
+		ScoreCT  int       `json:"score_ct"`
+		ScoreT   int       `json:"score_t"`
+		Messages []Message `json:"messages"`
+	}
+
+	// RoundTracker splits a stream of messages into rounds
+	RoundTracker struct {
+		number  int
+		live    bool
+		started bool
+		current *Round
+	}
+)
+
+// NewRoundTracker returns a RoundTracker in warmup
+func NewRoundTracker() *RoundTracker {
+	return &RoundTracker{}
+}
+
+// Rounds returns the completed rounds of the messages
+func Rounds(msgs []Message) []Round {
+
+	r := NewRoundTracker()
+	rounds := []Round{}
+
+	for _, m := range msgs {
+		if round := r.Update(m); round != nil {
+			rounds = append(rounds, *round)
+		}
+	}
+
+	return rounds
+}
+
+// Update adds the message to the current round and returns the round
+// if the message completes it, nil otherwise. A round starts with
+// FreezTimeStart, or WorldRoundStart without freeze time, and ends with
+// WorldRoundEnd. WorldRoundRestart aborts the round and restarts the
+// numbering, as does WorldMatchStart which also ends the warmup
+func (r *RoundTracker) Update(msg Message) *Round {
+
+	switch msg := msg.(type) {
+
+	case FreezTimeStart:
+		r.start(msg.Time)
+		r.started = false
+
+	case WorldRoundStart:
+		if r.current == nil || r.started {
+			r.start(msg.Time)
+		}
+		r.started = true
+
+	case WorldRoundRestart:
+		r.current = nil
+		r.number = 0
+		return nil
+
+	case WorldMatchStart:
+		r.live = true
+		r.number = 0
+		r.endWarmup()
+
+	case WorldGameCommencing:
+		if !r.live {
+			r.live = true
+			r.number = 0
+			r.endWarmup()
+		}
+	}
+
+	if r.current == nil {
+		return nil
+	}
+
+	r.current.Messages = append(r.current.Messages, msg)
+
+	switch msg := msg.(type) {
+
+	case TeamNotice:
+		r.current.Winner = msg.Side
+		r.current.Reason = WinReason(msg.Notice)
+		r.current.ScoreCT = msg.ScoreCT
+		r.current.ScoreT = msg.ScoreT
+
+	case WorldRoundEnd:
+		round := r.current
+		round.End = msg.Time
+		round.Kind = classify(round)
+
+		if round.Kind == RoundLive {
+			r.number++
+			round.Number = r.number
+		}
+
+		r.current = nil
+		r.started = false
+
+		return round
+	}
+
+	return nil
+}
+
+// Current returns the round in progress, nil between rounds
+func (r *RoundTracker) Current() *Round {
+	return r.current
+}
+
+// start begins a new round, an unfinished current round is dropped
+func (r *RoundTracker) start(ti time.Time) {
+	r.current = &Round{
+		Start:    ti,
+		Messages: []Message{},
+	}
+
+	if !r.live {
+		r.current.Kind = RoundWarmup
+	}
+}
+
+// endWarmup turns a warmup round in progress into a round to classify
+func (r *RoundTracker) endWarmup() {
+	if r.current != nil && r.current.Kind == RoundWarmup {
+		r.current.Kind = ""
+	}
+}
+
+// LiveRounds returns the live rounds, without warmup and knife rounds
+func LiveRounds(rounds []Round) []Round {
+
+	live := []Round{}
+
+	for _, r := range rounds {
+		if r.Kind == RoundLive {
+			live = append(live, r)
+		}
+	}
+
+	return live
+}
+
+// LiveMessages returns the messages of the live rounds, dropping messages
+// of warmup and knife rounds and messages between rounds
+func LiveMessages(msgs []Message) []Message {
+
+	live := []Message{}
+
+	for _, r := range LiveRounds(Rounds(msgs)) {
+		live = append(live, r.Messages...)
+	}
+
+	return live
+}
+
+// classify returns the kind of a completed round, a knife round has
+// at least one kill, all of them with a knife, and no purchases
+func classify(r *Round) string {
+
+	if r.Kind == RoundWarmup {
+		return RoundWarmup
+	}
+
+	kills := 0
+
+	for _, m := range r.Messages {
+		switch m := m.(type) {
+		case PlayerPurchase:
+			return RoundLive
+		case PlayerKill:
+			if !isKnife(m.Weapon) {
+				return RoundLive
+			}
+			kills++
+		}
+	}
+
+	if kills == 0 {
+		return RoundLive
+	}
+
+	return RoundKnife
+}
+
+// isKnife reports whether the weapon is a knife
+func isKnife(weapon string) bool {
+	return strings.HasPrefix(weapon, "knife") || weapon == "bayonet"
+}
@@ -0,0 +1,150 @@
+package csgolog
+
+import (
+	"testing"
+)
+
+func TestRounds(t *testing.T) {
+
+	t.Run("example log", func(t *testing.T) {
+
+		// given
+		msgs := readLog(t, "example/example.log")
+
+		// when
+		rounds := Rounds(msgs)
+
+		// then
+		assert(t, 18, len(rounds))
+		assert(t, RoundWarmup, rounds[0].Kind)
+		assert(t, 0, rounds[0].Number)
+		assert(t, RoundLive, rounds[1].Kind)
+		assert(t, 1, rounds[1].Number)
+		assert(t, "TERRORIST", rounds[1].Winner)
+		assert(t, WinReasonBombExploded, rounds[1].Reason)
+		assert(t, 17, rounds[17].Number)
+		assert(t, WinReasonBombDefused, rounds[17].Reason)
+		assert(t, 16, rounds[17].ScoreCT)
+	})
+
+	t.Run("round boundaries", func(t *testing.T) {
+
+		// given
+		msgs := parseLines(
+			`World triggered "Match_Start" on "de_dust2"`,
+			`Starting Freeze period`,
+			`World triggered "Round_Start"`,
+			`"Dean<11><BOT><CT>" [-206 316 1613] killed "Scott<5><BOT><TERRORIST>" [939 214 1678] with "hkp2000"`,
+			`Team "CT" triggered "SFUI_Notice_CTs_Win" (CT "1") (T "0")`,
+			`World triggered "Round_End"`,
+			`"Dean<11><BOT><CT>" money change 1250+3400 = $4650 (tracked)`,
+		)
+
+		// when
+		rounds := Rounds(msgs)
+
+		// then
+		assert(t, 1, len(rounds))
+		assert(t, 1, rounds[0].Number)
+		assert(t, RoundLive, rounds[0].Kind)
+		assert(t, 5, len(rounds[0].Messages))
+		assert(t, "CT", rounds[0].Winner)
+	})
+
+	t.Run("restart", func(t *testing.T) {
+
+		// given
+		msgs := parseLines(
+			`World triggered "Match_Start" on "de_dust2"`,
+			`World triggered "Round_Start"`,
+			`Team "CT" triggered "SFUI_Notice_CTs_Win" (CT "1") (T "0")`,
+			`World triggered "Round_End"`,
+			`World triggered "Round_Start"`,
+			`World triggered "Restart_Round_(1_second)"`,
+			`World triggered "Round_End"`,
+			`World triggered "Round_Start"`,
+			`Team "CT" triggered "SFUI_Notice_CTs_Win" (CT "1") (T "0")`,
+			`World triggered "Round_End"`,
+		)
+
+		// when
+		rounds := Rounds(msgs)
+
+		// then
+		assert(t, 2, len(rounds))
+		assert(t, 1, rounds[0].Number)
+		assert(t, 1, rounds[1].Number)
+	})
+
+	t.Run("streaming", func(t *testing.T) {
+
+		// given
+		r := NewRoundTracker()
+		msgs := parseLines(
+			`World triggered "Round_Start"`,
+			`Team "CT" triggered "SFUI_Notice_CTs_Win" (CT "1") (T "0")`,
+			`World triggered "Round_End"`,
+		)
+
+		// when
+		first := r.Update(msgs[0])
+		current := r.Current()
+		r.Update(msgs[1])
+		last := r.Update(msgs[2])
+
+		// then
+		assert(t, (*Round)(nil), first)
+		assert(t, RoundWarmup, current.Kind)
+		assert(t, current, last)
+		assert(t, (*Round)(nil), r.Current())
+	})
+
+	t.Run("knife round", func(t *testing.T) {
+
+		// given
+		msgs := parseLines(
+			`World triggered "Match_Start" on "de_dust2"`,
+			`World triggered "Round_Start"`,
+			`"Dean<11><BOT><CT>" [-206 316 1613] killed "Scott<5><BOT><TERRORIST>" [939 214 1678] with "knife_t"`,
+			`Team "CT" triggered "SFUI_Notice_CTs_Win" (CT "1") (T "0")`,
+			`World triggered "Round_End"`,
+			`World triggered "Round_Start"`,
+			`"Dean<11><BOT><CT>" purchased "m4a1"`,
+			`"Dean<11><BOT><CT>" [-206 316 1613] killed "Scott<5><BOT><TERRORIST>" [939 214 1678] with "knife"`,
+			`Team "CT" triggered "SFUI_Notice_CTs_Win" (CT "1") (T "0")`,
+			`World triggered "Round_End"`,
+		)
+
+		// when
+		rounds := Rounds(msgs)
+
+		// then
+		assert(t, 2, len(rounds))
+		assert(t, RoundKnife, rounds[0].Kind)
+		assert(t, 0, rounds[0].Number)
+		assert(t, RoundLive, rounds[1].Kind)
+		assert(t, 1, rounds[1].Number)
+	})
+
+	t.Run("live filter", func(t *testing.T) {
+
+		// given
+		msgs := parseLines(
+			`World triggered "Round_Start"`,
+			`"Dean<11><BOT><CT>" [-206 316 1613] killed "Scott<5><BOT><TERRORIST>" [939 214 1678] with "ak47"`,
+			`World triggered "Round_End"`,
+			`World triggered "Match_Start" on "de_dust2"`,
+			`World triggered "Round_Start"`,
+			`"Dean<11><BOT><CT>" [-206 316 1613] killed "Scott<5><BOT><TERRORIST>" [939 214 1678] with "ak47"`,
+			`World triggered "Round_End"`,
+		)
+
+		// when
+		live := LiveMessages(msgs)
+
+		// then
+		assert(t, 1, len(LiveRounds(Rounds(msgs))))
+		assert(t, 3, len(live))
+		assert(t, "WorldRoundStart", live[0].GetType())
+	})
+}
@@ -0,0 +1,114 @@
+package csgolog
+
+// PlayerStats holds the scoreboard line of a player, Rounds are the
+// live rounds the player took part in and ADR the average damage
+// dealt to enemies per round. Assists are damage assists only,
+// flash assists are counted in FlashAssists
+type PlayerStats struct {
+	Player       Player  `json:"player"`
+	Rounds       int     `json:"rounds"`
+	Kills        int     `json:"kills"`
+	Deaths       int     `json:"deaths"`
+	Assists      int     `json:"assists"`
+	FlashAssists int     `json:"flash_assists"`
+	Headshots    int     `json:"headshots"`
+	TeamKills    int     `json:"team_kills"`
+	Damage       int     `json:"damage"`
+	ADR          float32 `json:"adr"`
+}
+
+// Scoreboard returns the stats of each player over the live rounds of
+// the messages, warmup and knife rounds are not counted. The players
+// are in the order of their first appearance
+func Scoreboard(msgs []Message) []*PlayerStats {
+
+	stats := []*PlayerStats{}
+	players := map[string]*PlayerStats{}
+
+	get := func(p Player) *PlayerStats {
+
+		key := playerKey(p)
+
+		if s, ok := players[key]; ok {
+			s.Player = p
+			return s
+		}
+
+		s := &PlayerStats{Player: p}
+		players[key] = s
+		stats = append(stats, s)
+
+		return s
+	}
+
+	for _, r := range LiveRounds(Rounds(msgs)) {
+
+		played := map[*PlayerStats]bool{}
+		health := map[string]int{}
+
+		for _, m := range r.Messages {
+
+			for _, p := range Players(m) {
+				played[get(p)] = true
+			}
+
+			switch m := m.(type) {
+
+			case PlayerKill:
+				if m.Attacker.Side == m.Victim.Side {
+					get(m.Attacker).TeamKills++
+				} else {
+					get(m.Attacker).Kills++
+					if m.Headshot {
+						get(m.Attacker).Headshots++
+					}
+				}
+				get(m.Victim).Deaths++
+
+			case PlayerKilledSuicide:
+				get(m.Player).Deaths++
+
+			case PlayerKilledBomb:
+				get(m.Player).Deaths++
+
+			case PlayerKilledWorld:
+				get(m.Player).Deaths++
+
+			case PlayerKillAssist:
+				if m.Kind == AssistFlash {
+					get(m.Attacker).FlashAssists++
+				} else {
+					get(m.Attacker).Assists++
+				}
+
+			case PlayerAttack:
+				// the logged damage is not capped by the health left
+				key := playerKey(m.Victim)
+				left, ok := health[key]
+				if !ok {
+					left = 100
+				}
+				health[key] = m.Health
+
+				if m.Attacker.Side != m.Victim.Side {
+					if m.Damage < left {
+						left = m.Damage
+					}
+					get(m.Attacker).Damage += left
+				}
+			}
+		}
+
+		for s := range played {
+			s.Rounds++
+		}
+	}
+
+	for _, s := range stats {
+		if s.Rounds > 0 {
+			s.ADR = float32(s.Damage) / float32(s.Rounds)
+		}
+	}
+
+	return stats
+}
@@ -0,0 +1,58 @@
+package csgolog
+
+import (
+	"testing"
+)
+
+func TestScoreboard(t *testing.T) {
+
+	t.Run("live rounds only", func(t *testing.T) {
+
+		// given
+		msgs := parseLines(
+			`World triggered "Round_Start"`,
+			`"Dean<11><BOT><CT>" [-206 316 1613] killed "Scott<5><BOT><TERRORIST>" [939 214 1678] with "ak47"`,
+			`World triggered "Round_End"`,
+			`World triggered "Match_Start" on "de_dust2"`,
+			`World triggered "Round_Start"`,
+			`"Dean<11><BOT><CT>" [-206 316 1613] attacked "Scott<5><BOT><TERRORIST>" [939 214 1678] with "awp" (damage "448") (damage_armor "0") (health "0") (armor "0") (hitgroup "head")`,
+			`"Dean<11><BOT><CT>" [-206 316 1613] killed "Scott<5><BOT><TERRORIST>" [939 214 1678] with "awp" (headshot)`,
+			`"Jon<9><BOT><CT>" assisted killing "Scott<5><BOT><TERRORIST>"`,
+			`"Jon<9><BOT><CT>" flash-assisted killing "Scott<5><BOT><TERRORIST>"`,
+			`World triggered "Round_End"`,
+		)
+
+		// when
+		stats := Scoreboard(msgs)
+
+		// then
+		assert(t, 3, len(stats))
+		assert(t, "Dean", stats[0].Player.Name)
+		assert(t, 1, stats[0].Kills)
+		assert(t, 1, stats[0].Headshots)
+		assert(t, 100, stats[0].Damage)
+		assert(t, float32(100), stats[0].ADR)
+		assert(t, 1, stats[1].Deaths)
+		assert(t, 1, stats[2].Assists)
+		assert(t, 1, stats[2].FlashAssists)
+	})
+
+	t.Run("team kill", func(t *testing.T) {
+
+		// given
+		msgs := parseLines(
+			`World triggered "Match_Start" on "de_dust2"`,
+			`World triggered "Round_Start"`,
+			`"Dean<11><BOT><CT>" [-206 316 1613] killed "Jon<9><BOT><CT>" [939 214 1678] with "ak47"`,
+			`World triggered "Round_End"`,
+		)
+
+		// when
+		stats := Scoreboard(msgs)
+
+		// then
+		assert(t, 0, stats[0].Kills)
+		assert(t, 1, stats[0].TeamKills)
+		assert(t, 1, stats[1].Deaths)
+	})
+}
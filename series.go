@@ -0,0 +1,337 @@
+package csgolog
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// DefaultSeriesGap is the maximum time between the end of a map
+// and the start of the next map of the same series
+const DefaultSeriesGap = time.Hour
+
+type (
+
+	// SeriesTeam is a team of a series, Players holds the identity ids
+	// of the players. Teams without a name logged by TeamPlaying in a
+	// match are named after their first player like the game does
+	SeriesTeam struct {
+		Name    string   `json:"name"`
+		Players []string `json:"players"`
+
+		named bool
+	}
+
+	// MapResult holds the result of a single map of a series,
+	// Score is indexed like the Teams of the series
+	MapResult struct {
+		Map     string         `json:"map"`
+		Start   time.Time      `json:"start"`
+		End     time.Time      `json:"end"`
+		Score   [2]int         `json:"score"`
+		Winner  string         `json:"winner"`
+		Players []*PlayerStats `json:"players"`
+	}
+
+	// Series holds the maps of a series between two teams, the
+	// player stats are aggregated over all maps
+	Series struct {
+		Teams   [2]*SeriesTeam `json:"teams"`
+		Maps    []*MapResult   `json:"maps"`
+		Score   [2]int         `json:"score"`
+		Winner  string         `json:"winner"`
+		Players []*PlayerStats `json:"players"`
+	}
+
+	// SeriesOptions configure how matches are grouped into series. A match
+	// starts a new series if it starts more than MaxGap after the end of the
+	// previous one or, with ByRoster, if less than half of the players of a
+	// team played for a team of the series before
+	SeriesOptions struct {
+		MaxGap   time.Duration
+		ByRoster bool
+	}
+
+	// seriesMatch is a finished match with its teams
+	seriesMatch struct {
+		result *MapResult
+		teams  [2]*SeriesTeam
+		score  [2]int
+	}
+)
+
+// GroupSeries groups the finished matches of the messages into series,
+// a match spans from WorldMatchStart to GameOver. Pass the messages of
+// consecutive logfiles in order to group matches across files
+func GroupSeries(msgs []Message, opts SeriesOptions) []*Series {
+
+	if opts.MaxGap == 0 {
+		opts.MaxGap = DefaultSeriesGap
+	}
+
+	series := []*Series{}
+	var current *Series
+
+	for _, m := range seriesMatches(msgs) {
+
+		if current != nil {
+			last := current.Maps[len(current.Maps)-1]
+			if m.result.Start.Sub(last.End) > opts.MaxGap ||
+				(opts.ByRoster && !current.sameRoster(m.teams)) {
+				current = nil
+			}
+		}
+
+		if current == nil {
+			current = &Series{Teams: m.teams, Maps: []*MapResult{}}
+			series = append(series, current)
+		}
+
+		current.add(m)
+	}
+
+	for _, s := range series {
+		s.Players = aggregateStats(s.Maps)
+	}
+
+	return series
+}
+
+// ToJSON marshals the series to JSON without escaping html
+func (s *Series) ToJSON() string {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	return buf.String()
+}
+
+// add adds a match to the series, its teams are mapped to
+// the teams of the series by name or else by players
+func (s *Series) add(m seriesMatch) {
+
+	first := s.team(m.teams[0])
+	order := [2]int{first, 1 - first}
+
+	r := m.result
+	r.Score = [2]int{m.score[order[0]], m.score[order[1]]}
+	s.Maps = append(s.Maps, r)
+
+	for i, t := range order {
+		s.Teams[t].Players = union(s.Teams[t].Players, m.teams[i].Players)
+		if m.teams[i].named {
+			s.Teams[t].Name = m.teams[i].Name
+			s.Teams[t].named = true
+		}
+	}
+
+	switch {
+	case r.Score[0] > r.Score[1]:
+		r.Winner = s.Teams[0].Name
+		s.Score[0]++
+	case r.Score[1] > r.Score[0]:
+		r.Winner = s.Teams[1].Name
+		s.Score[1]++
+	}
+
+	switch {
+	case s.Score[0] > s.Score[1]:
+		s.Winner = s.Teams[0].Name
+	case s.Score[1] > s.Score[0]:
+		s.Winner = s.Teams[1].Name
+	default:
+		s.Winner = ""
+	}
+}
+
+// team returns the index of the team of the series matching t
+func (s *Series) team(t *SeriesTeam) int {
+
+	for i, st := range s.Teams {
+		if t.named && st.named && t.Name == st.Name {
+			return i
+		}
+	}
+
+	if overlap(t.Players, s.Teams[1].Players) > overlap(t.Players, s.Teams[0].Players) {
+		return 1
+	}
+
+	return 0
+}
+
+// sameRoster reports whether at least half of the players
+// of both teams played for a team of the series before
+func (s *Series) sameRoster(teams [2]*SeriesTeam) bool {
+
+	for _, t := range teams {
+
+		best := 0
+
+		for _, st := range s.Teams {
+			if n := overlap(t.Players, st.Players); n > best {
+				best = n
+			}
+		}
+
+		if best*2 < len(t.Players) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// seriesMatches returns the finished matches of the messages, the
+// roster is tracked across matches to keep the teams on side swaps
+func seriesMatches(msgs []Message) []seriesMatch {
+
+	matches := []seriesMatch{}
+	roster := NewRoster()
+	var segment []Message
+
+	for _, m := range msgs {
+
+		roster.Update(m)
+
+		if _, ok := m.(WorldMatchStart); ok {
+			segment = []Message{}
+		}
+
+		if segment == nil {
+			continue
+		}
+
+		segment = append(segment, m)
+
+		if _, ok := m.(GameOver); ok {
+			matches = append(matches, newSeriesMatch(segment, roster))
+			segment = nil
+		}
+	}
+
+	return matches
+}
+
+// newSeriesMatch returns the result and the teams of a finished match
+func newSeriesMatch(msgs []Message, roster *Roster) seriesMatch {
+
+	match := NewMatch()
+
+	for _, m := range msgs {
+		match.Update(m)
+	}
+
+	stats := Scoreboard(msgs)
+	sm := seriesMatch{
+		result: &MapResult{
+			Map:     match.Map,
+			Start:   msgs[0].GetTime(),
+			End:     msgs[len(msgs)-1].GetTime(),
+			Players: stats,
+		},
+	}
+
+	// the roster keeps the names of previous matches to detect side
+	// swaps, a team is named only if the match logged its name
+	names := map[string]bool{}
+
+	for _, m := range msgs {
+		if tp, ok := m.(TeamPlaying); ok {
+			names[tp.Team] = true
+		}
+	}
+
+	for i, t := range roster.Teams {
+
+		st := &SeriesTeam{Players: []string{}, named: names[t.Name]}
+
+		if st.named {
+			st.Name = t.Name
+		}
+
+		for _, s := range stats {
+			if roster.Team(s.Player) == t {
+				st.Players = append(st.Players, roster.Identities.Resolve(s.Player).ID)
+				if st.Name == "" {
+					st.Name = "team_" + s.Player.Name
+				}
+			}
+		}
+
+		sm.teams[i] = st
+
+		if t.Side == "CT" {
+			sm.score[i] = match.ScoreCT
+		} else {
+			sm.score[i] = match.ScoreT
+		}
+	}
+
+	return sm
+}
+
+// aggregateStats sums the player stats of all maps
+func aggregateStats(maps []*MapResult) []*PlayerStats {
+
+	stats := []*PlayerStats{}
+	players := map[string]*PlayerStats{}
+
+	for _, m := range maps {
+		for _, s := range m.Players {
+
+			key := playerKey(s.Player)
+			a, ok := players[key]
+
+			if !ok {
+				a = &PlayerStats{}
+				players[key] = a
+				stats = append(stats, a)
+			}
+
+			a.Player = s.Player
+			a.Rounds += s.Rounds
+			a.Kills += s.Kills
+			a.Deaths += s.Deaths
+			a.Assists += s.Assists
+			a.FlashAssists += s.FlashAssists
+			a.Headshots += s.Headshots
+			a.TeamKills += s.TeamKills
+			a.Damage += s.Damage
+		}
+	}
+
+	for _, s := range stats {
+		if s.Rounds > 0 {
+			s.ADR = float32(s.Damage) / float32(s.Rounds)
+		}
+	}
+
+	return stats
+}
+
+// overlap returns the number of elements of a contained in b
+func overlap(a, b []string) int {
+
+	n := 0
+
+	for _, v := range a {
+		if contains(b, v) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// union appends the elements of b missing in a
+func union(a, b []string) []string {
+
+	for _, v := range b {
+		if !contains(a, v) {
+			a = append(a, v)
+		}
+	}
+
+	return a
+}
@@ -0,0 +1,126 @@
+package csgolog
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGroupSeries(t *testing.T) {
+
+	t.Run("series with time gap", func(t *testing.T) {
+
+		// given
+		msgs := readLog(t, "testdata/series.log")
+
+		// when
+		series := GroupSeries(msgs, SeriesOptions{})
+
+		// then
+		assert(t, 2, len(series))
+		assert(t, 2, len(series[0].Maps))
+		assert(t, "Alpha", series[0].Teams[0].Name)
+		assert(t, "de_mirage", series[0].Maps[1].Map)
+		assert(t, [2]int{1, 0}, series[0].Maps[1].Score)
+		assert(t, "Alpha", series[0].Maps[1].Winner)
+		assert(t, [2]int{2, 0}, series[0].Score)
+		assert(t, "Alpha", series[0].Winner)
+		assert(t, 2, series[0].Players[0].Kills)
+		assert(t, 2, series[0].Players[1].Deaths)
+		assert(t, 2, series[0].Players[2].FlashAssists)
+		assert(t, 0, series[0].Players[2].Assists)
+		assert(t, "team_Ben", series[1].Winner)
+	})
+
+	t.Run("series by roster", func(t *testing.T) {
+
+		// given
+		msgs := parseLines(
+			`World triggered "Match_Start" on "de_dust2"`,
+			`World triggered "Round_Start"`,
+			`"Anna<2><STEAM_1:0:1001><CT>" [100 200 0] killed "Ben<3><STEAM_1:0:2002><TERRORIST>" [300 400 0] with "m4a1"`,
+			`World triggered "Round_End"`,
+			`Game Over: competitive mg_active de_dust2 score 1:0 after 1 min`,
+			`"Anna<2><STEAM_1:0:1001><CT>" disconnected (reason "Disconnect")`,
+			`"Ben<3><STEAM_1:0:2002><TERRORIST>" disconnected (reason "Disconnect")`,
+			`"Carl<2><STEAM_1:0:3003>" switched from team <Unassigned> to <CT>`,
+			`"Dora<3><STEAM_1:0:4004>" switched from team <Unassigned> to <TERRORIST>`,
+			`World triggered "Match_Start" on "de_dust2"`,
+			`World triggered "Round_Start"`,
+			`"Carl<2><STEAM_1:0:3003><CT>" [100 200 0] killed "Dora<3><STEAM_1:0:4004><TERRORIST>" [300 400 0] with "m4a1"`,
+			`World triggered "Round_End"`,
+			`Game Over: competitive mg_active de_dust2 score 1:0 after 1 min`,
+		)
+
+		// when
+		byGap := GroupSeries(msgs, SeriesOptions{})
+		byRoster := GroupSeries(msgs, SeriesOptions{ByRoster: true})
+
+		// then
+		assert(t, 1, len(byGap))
+		assert(t, 2, len(byRoster))
+		assert(t, "team_Carl", byRoster[1].Teams[0].Name)
+	})
+
+	t.Run("unnamed teams after named teams", func(t *testing.T) {
+
+		// given
+		msgs := parseLines(
+			`World triggered "Match_Start" on "de_dust2"`,
+			`MatchStatus: Team playing "CT": Alpha`,
+			`MatchStatus: Team playing "TERRORIST": Bravo`,
+			`World triggered "Round_Start"`,
+			`"Anna<2><STEAM_1:0:1001><CT>" [100 200 0] killed "Ben<3><STEAM_1:0:2002><TERRORIST>" [300 400 0] with "m4a1"`,
+			`World triggered "Round_End"`,
+			`Game Over: competitive mg_active de_dust2 score 1:0 after 1 min`,
+			`World triggered "Match_Start" on "de_mirage"`,
+			`World triggered "Round_Start"`,
+			`"Xena<4><STEAM_1:0:3003><CT>" [100 200 0] killed "Yuri<5><STEAM_1:0:4004><TERRORIST>" [300 400 0] with "m4a1"`,
+			`World triggered "Round_End"`,
+			`Game Over: competitive mg_active de_mirage score 1:0 after 1 min`,
+		)
+
+		// when
+		series := GroupSeries(msgs, SeriesOptions{ByRoster: true})
+
+		// then
+		assert(t, 2, len(series))
+		assert(t, "Alpha", series[0].Winner)
+		assert(t, "team_Xena", series[1].Teams[0].Name)
+		assert(t, "team_Yuri", series[1].Teams[1].Name)
+		assert(t, "team_Xena", series[1].Winner)
+	})
+
+	t.Run("unfinished match", func(t *testing.T) {
+
+		// given
+		msgs := readLog(t, "example/example.log")
+
+		// when
+		series := GroupSeries(msgs, SeriesOptions{})
+
+		// then
+		assert(t, 1, len(series))
+		assert(t, 1, len(series[0].Maps))
+		assert(t, "team_Player", series[0].Winner)
+	})
+
+	t.Run("json", func(t *testing.T) {
+
+		// given
+		series := GroupSeries(readLog(t, "testdata/series.log"), SeriesOptions{})
+		var have struct {
+			Score [2]int `json:"score"`
+			Maps  []struct {
+				Map string `json:"map"`
+			} `json:"maps"`
+		}
+
+		// when
+		err := json.Unmarshal([]byte(series[0].ToJSON()), &have)
+
+		// then
+		assert(t, nil, err)
+		assert(t, [2]int{2, 0}, have.Score)
+		assert(t, "de_dust2", have.Maps[0].Map)
+	})
+}
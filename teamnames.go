@@ -0,0 +1,41 @@
+package csgolog
+
+// TeamNames resolves sides to the names of the teams playing on them,
+// it learns the names from TeamPlaying messages
+type TeamNames struct {
+	names map[string]string
+}
+
+// NewTeamNames returns an empty TeamNames
+func NewTeamNames() *TeamNames {
+	return &TeamNames{names: map[string]string{}}
+}
+
+// Update learns the team name of a side if m is a TeamPlaying message
+func (n *TeamNames) Update(m Message) {
+
+	if tp, ok := m.(TeamPlaying); ok {
+		n.names[normalizeSide(tp.Side)] = tp.Team
+	}
+}
+
+// Name returns the name of the team playing on side, the side
+// itself if the name is not known yet
+func (n *TeamNames) Name(side string) string {
+
+	if name, ok := n.names[normalizeSide(side)]; ok && name != "" {
+		return name
+	}
+
+	return side
+}
+
+// normalizeSide converts the short side T to TERRORIST
+func normalizeSide(side string) string {
+
+	if side == "T" {
+		return "TERRORIST"
+	}
+
+	return side
+}
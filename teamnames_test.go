@@ -0,0 +1,52 @@
+package csgolog
+
+import (
+	"testing"
+)
+
+func TestTeamNames(t *testing.T) {
+
+	t.Run("unknown side", func(t *testing.T) {
+
+		// given
+		n := NewTeamNames()
+
+		// then
+		assert(t, "CT", n.Name("CT"))
+	})
+
+	t.Run("resolve team scored and team notice", func(t *testing.T) {
+
+		// given
+		n := NewTeamNames()
+
+		for _, l := range []string{
+			line(`MatchStatus: Team playing "CT": Team Liquid`),
+			line(`MatchStatus: Team playing "TERRORIST": Natus Vincere`),
+		} {
+			m, _ := Parse(l)
+			n.Update(m)
+		}
+
+		ts, _ := Parse(line(`Team "CT" scored "1" with "5" players`))
+		tn, _ := Parse(line(`Team "TERRORIST" triggered "SFUI_Notice_Terrorists_Win" (CT "1") (T "1")`))
+
+		// then
+		assert(t, "Team Liquid", n.Name(ts.(TeamScored).Side))
+		assert(t, "Natus Vincere", n.Name(tn.(TeamNotice).Side))
+		assert(t, "Natus Vincere", n.Name("T"))
+	})
+
+	t.Run("side swap", func(t *testing.T) {
+
+		// given
+		n := NewTeamNames()
+
+		// when
+		n.Update(TeamPlaying{Side: "CT", Team: "Team Liquid"})
+		n.Update(TeamPlaying{Side: "CT", Team: "Natus Vincere"})
+
+		// then
+		assert(t, "Natus Vincere", n.Name("CT"))
+	})
+}
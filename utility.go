@@ -0,0 +1,107 @@
+package csgolog
+
+// Utility holds the utility usage of a player, damage is the
+// damage dealt to enemies and BlindDuration the seconds enemies
+// were blinded
+type Utility struct {
+	Player           Player         `json:"player"`
+	Thrown           map[string]int `json:"thrown"`
+	EnemiesFlashed   int            `json:"enemies_flashed"`
+	TeammatesFlashed int            `json:"teammates_flashed"`
+	SelfFlashed      int            `json:"self_flashed"`
+	BlindDuration    float32        `json:"blind_duration"`
+	AvgBlindDuration float32        `json:"avg_blind_duration"`
+	HEDamage         int            `json:"he_damage"`
+	MolotovDamage    int            `json:"molotov_damage"`
+	FlashAssists     int            `json:"flash_assists"`
+}
+
+// UtilityStats returns the utility usage of each player throwing
+// a grenade or assisting by flash in the order of their first appearance
+func UtilityStats(msgs []Message) []*Utility {
+
+	stats := []*Utility{}
+	players := map[string]*Utility{}
+
+	get := func(p Player) *Utility {
+
+		key := playerKey(p)
+
+		if u, ok := players[key]; ok {
+			u.Player = p
+			return u
+		}
+
+		u := &Utility{Player: p, Thrown: map[string]int{}}
+		players[key] = u
+		stats = append(stats, u)
+
+		return u
+	}
+
+	g := NewGrenadeTracker()
+
+	for _, m := range msgs {
+
+		if a, ok := m.(PlayerKillAssist); ok && a.Kind == AssistFlash {
+			get(a.Attacker).FlashAssists++
+		}
+
+		// the tracker drops its grenades on a match start
+		if _, ok := m.(WorldMatchStart); ok {
+			g.utility(get)
+		}
+
+		g.Update(m)
+	}
+
+	g.utility(get)
+
+	for _, u := range stats {
+		if u.EnemiesFlashed > 0 {
+			u.AvgBlindDuration = u.BlindDuration / float32(u.EnemiesFlashed)
+		}
+	}
+
+	return stats
+}
+
+// utility adds the grenades of the tracker to the utility of their throwers
+func (g *GrenadeTracker) utility(get func(p Player) *Utility) {
+
+	for _, gr := range g.Grenades {
+
+		u := get(gr.Thrower)
+		u.Thrown[gr.Grenade]++
+		u.EnemiesFlashed += gr.EnemiesBlinded
+		u.TeammatesFlashed += gr.TeammatesBlinded
+
+		if gr.SelfBlinded {
+			u.SelfFlashed++
+		}
+
+		for _, b := range gr.Blinded {
+			if !b.Teammate && !b.Self {
+				u.BlindDuration += b.For
+			}
+		}
+
+		switch gr.Grenade {
+		case "hegrenade":
+			u.HEDamage += gr.EnemyDamage
+		case "molotov", "incgrenade":
+			u.MolotovDamage += gr.EnemyDamage
+		}
+	}
+}
+
+// playerKey returns a key identifying a player, bots are
+// identified by name as they have no steam id
+func playerKey(p Player) string {
+
+	if p.SteamID == "" || p.SteamID == "BOT" {
+		return "BOT_" + p.Name
+	}
+
+	return p.SteamID
+}
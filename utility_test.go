@@ -0,0 +1,63 @@
+package csgolog
+
+import (
+	"testing"
+)
+
+func TestUtilityStats(t *testing.T) {
+
+	t.Run("utility per player", func(t *testing.T) {
+
+		// when
+		u := UtilityStats(parseLines(
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" threw flashbang [-716 -1636 -170] flashbang entindex 163)`,
+			`"Bravo<3><STEAM_1:0:1002><CT>" blinded for 3.00 by "Alpha<2><STEAM_1:0:1001><TERRORIST>" from flashbang entindex 163`,
+			`"Charlie<4><BOT><CT>" blinded for 1.00 by "Alpha<2><STEAM_1:0:1001><TERRORIST>" from flashbang entindex 163`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" blinded for 2.00 by "Alpha<2><STEAM_1:0:1001><TERRORIST>" from flashbang entindex 163`,
+			`"Delta<5><STEAM_1:0:1004><TERRORIST>" blinded for 1.50 by "Alpha<2><STEAM_1:0:1001><TERRORIST>" from flashbang entindex 163`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" flash-assisted killing "Bravo<3><STEAM_1:0:1002><CT>"`,
+			`"Charlie<4><BOT><CT>" threw hegrenade [0 0 0]`,
+			`"Charlie<4><BOT><CT>" [0 0 0] attacked "Alpha<2><STEAM_1:0:1001><TERRORIST>" [0 0 0] with "hegrenade" (damage "25") (damage_armor "0") (health "75") (armor "0") (hitgroup "generic")`,
+			`World triggered "Match_Start" on "de_cache"`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" threw molotov [-716 -1636 -170]`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" [0 0 0] attacked "Bravo<3><STEAM_1:0:1002><CT>" [0 0 0] with "inferno" (damage "12") (damage_armor "0") (health "88") (armor "0") (hitgroup "generic")`,
+			`"Alpha<2><STEAM_1:0:1001><TERRORIST>" [0 0 0] attacked "Bravo<3><STEAM_1:0:1002><CT>" [0 0 0] with "assist" (damage "12") (damage_armor "0") (health "88") (armor "0") (hitgroup "generic")`,
+		))
+
+		// then
+		assert(t, 2, len(u))
+
+		assert(t, "Alpha", u[0].Player.Name)
+		assert(t, 1, u[0].Thrown["flashbang"])
+		assert(t, 1, u[0].Thrown["molotov"])
+		assert(t, 2, u[0].EnemiesFlashed)
+		assert(t, 1, u[0].TeammatesFlashed)
+		assert(t, 1, u[0].SelfFlashed)
+		assert(t, float32(4), u[0].BlindDuration)
+		assert(t, float32(2), u[0].AvgBlindDuration)
+		assert(t, 0, u[0].HEDamage)
+		assert(t, 12, u[0].MolotovDamage)
+		assert(t, 1, u[0].FlashAssists)
+
+		assert(t, "Charlie", u[1].Player.Name)
+		assert(t, 1, u[1].Thrown["hegrenade"])
+		assert(t, 25, u[1].HEDamage)
+		assert(t, float32(0), u[1].AvgBlindDuration)
+	})
+
+	t.Run("no utility", func(t *testing.T) {
+
+		// when
+		u := UtilityStats(parseLines(`World triggered "Round_Start"`))
+
+		// then
+		assert(t, 0, len(u))
+	})
+}
+
+func TestPlayerKey(t *testing.T) {
+
+	// then
+	assert(t, "STEAM_1:0:1001", playerKey(Player{Name: "Alpha", SteamID: "STEAM_1:0:1001"}))
+	assert(t, "BOT_Charlie", playerKey(Player{Name: "Charlie", SteamID: "BOT"}))
+}